@@ -1,81 +1,186 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
 	"net/http"
-	"os"
 	"strings"
+	"sync/atomic"
 
 	"github.com/gin-gonic/gin"
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/eidng8/go-simple-tree/ent"
-	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
+const (
+	// HeaderActor is the request header naming who makes the request.
+	HeaderActor = "X-Actor"
+	// HeaderRequestId is the request header carrying the request ID.
+	HeaderRequestId = "X-Request-ID"
+)
+
 type Server struct {
 	EC      *ent.Client
 	BaseURL string
+	// BaseURI is the path prefix of all item endpoints.
+	BaseURI string
+	Events  *EventLog
+	Outbox  *OutboxRelay
+	// Admins are subjects allowed everything, regardless of grants.
+	Admins []string
+	// Ready reports whether the server is ready to take requests.
+	Ready *atomic.Bool
+	// MaxCopySize is the maximum number of items copied at once.
+	MaxCopySize int
 }
 
 func (s Server) BaseUrl() string {
-	return strings.TrimRight(s.BaseURL, "/") + "/" +
-		strings.TrimLeft(schema.BaseUri, "/")
+	return strings.TrimRight(s.BaseURL, "/") + s.BaseURI
 }
 
 var _ StrictServerInterface = (*Server)(nil)
 
-func newServer(entClient *ent.Client) Server {
+func newServer(entClient *ent.Client, cfg *Config) Server {
+	events := NewEventLog(cfg.Events.LogSize)
 	return Server{
 		EC:      entClient,
-		BaseURL: os.Getenv("BASE_URL"),
+		BaseURL: cfg.BaseURL,
+		BaseURI: cfg.BaseURI,
+		Events:  events,
+		Outbox: newOutboxRelay(
+			entClient, cfg.Events.OutboxRetention, events,
+		),
+		Admins:      cfg.Auth.Admins,
+		Ready:       &atomic.Bool{},
+		MaxCopySize: cfg.Limits.MaxCopySize,
 	}
 }
 
-func newEngine(entClient *ent.Client) (*Server, *gin.Engine, error) {
+func newEngine(
+	entClient *ent.Client, cfg *Config,
+) (*Server, *gin.Engine, error) {
 	swagger, err := GetSwagger()
 	if err != nil {
 		return &Server{}, nil, err
 	}
-	swagger.Servers = nil
-	getEnvWithDefault(gin.EnvGinMode, gin.ReleaseMode)
-	engine := gin.Default()
-	server := newServer(entClient)
-	handler := NewStrictHandler(server, []StrictMiddlewareFunc{})
-	RegisterHandlers(engine, handler)
-	// RegisterHandlersWithOptions(
-	// 	engine, handler, GinServerOptions{
-	// 		ErrorHandler: func(ctx *gin.Context, err error, code int) {
-	// 			// This doesn't work since the error is generated by fmt.Errorf().
-	// 			// Such error cannot be converted to err.ValidationError.
-	// 			if ent.IsValidationError(err) {
-	// 				code = http.StatusUnprocessableEntity
-	// 			}
-	// 			ctx.JSON(code, gin.H{"error": err.Error()})
-	// 		},
-	// 	},
-	// )
+	rebaseSpec(swagger, cfg.BaseURI)
+	specJson, specYaml, err := specHandlers(swagger, cfg.BaseURL)
+	if err != nil {
+		return &Server{}, nil, err
+	}
+	gin.SetMode(cfg.GinMode)
+	engine := gin.New()
+	// let handlers & ent hooks see values attached to the request context
+	engine.ContextWithFallback = true
+	engine.Use(
+		requestIdMiddleware, tracingMiddleware, accessLogMiddleware,
+		recoveryMiddleware(), errorFormatMiddleware(cfg.ErrorFormat),
+	)
+	server := newServer(entClient, cfg)
+	// probes are served without authentication, and not counted in metrics
+	engine.GET("/healthz", healthzHandler)
+	engine.GET("/readyz", readyzHandler(entClient, server.Ready))
+	// so is the spec, letting integrators discover the API
+	engine.GET("/openapi.json", specJson)
+	engine.GET("/openapi.yaml", specYaml)
+	if cfg.Docs.Enabled {
+		engine.GET("/docs", docsHandler)
+		engine.GET("/docs/swagger-ui.css", docsAssetHandler)
+		engine.GET("/docs/swagger-ui-bundle.js", docsAssetHandler)
+	}
+	if cfg.Metrics.Enabled {
+		// registered before other middlewares to be served without
+		// authentication, and not to be counted itself
+		engine.GET("/metrics", metricsHandler(newMetricsRegistry(entClient)))
+		engine.Use(metricsMiddleware(operationNames(swagger)))
+	}
+	authenticators, err := getAuthenticators(cfg.Auth)
+	if err != nil {
+		return &Server{}, nil, err
+	}
+	err = engine.SetTrustedProxies(cfg.Limits.TrustedProxies)
+	if err != nil {
+		return &Server{}, nil, fmt.Errorf("TRUSTED_PROXIES: %w", err)
+	}
+	limits, err := getRateLimits(cfg.Limits)
+	if err != nil {
+		return &Server{}, nil, err
+	}
+	limiter := NewRateLimiter(limits)
+	if len(authenticators) > 0 {
+		server.Events.Readers = func(
+			ctx context.Context, id uint32,
+		) ([]string, error) {
+			return readers(ctx, entClient, id)
+		}
+		if len(limits) > 0 {
+			engine.Use(limiter.authGuard)
+		}
+		engine.Use(authMiddleware(authenticators...))
+	}
+	if len(limits) > 0 {
+		engine.Use(limiter.middleware)
+	}
+	engine.Use(bodyLimitMiddleware(int64(cfg.Limits.MaxBodySize)))
+	engine.Use(validationMiddleware(swagger))
+	engine.Use(auditMiddleware)
+	handler := NewStrictHandler(
+		server, []StrictMiddlewareFunc{detachContext, traceOperation},
+	)
+	RegisterHandlersWithOptions(
+		rebasedRouter{engine.Group(cfg.BaseURI)}, handler,
+		GinServerOptions{ErrorHandler: parameterErrorHandler},
+	)
 	return &server, engine, nil
 }
 
-// handleErrorResponse replaces oapi-codegen generated error handling.
+// handleErrorResponse replaces oapi-codegen generated error handling, and is
+// where errors returned by handlers are mapped to their ApiErrors. Errors are
+// answered with the body of their ApiError, and logged, server errors at
+// error level, others at info level.
 func handleErrorResponse(ctx *gin.Context, err error) {
 	_ = ctx.Error(err)
-	switch {
-	case ent.IsValidationError(err):
-		ctx.Status(http.StatusUnprocessableEntity)
-	case ent.IsNotFound(err):
-		ctx.Status(http.StatusNotFound)
-	default:
-		ctx.Status(http.StatusInternalServerError)
+	apiErr := toApiError(err)
+	abortWithError(ctx, apiErr)
+	level := slog.LevelInfo
+	if apiErr.Code >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	loggerFromContext(ctx.Request.Context()).Log(
+		ctx, level, "handler error",
+		"method", ctx.Request.Method,
+		"route", routePath(ctx),
+		"status", apiErr.Code,
+		"error", err.Error(),
+	)
+}
+
+// auditMiddleware attaches the actor and request ID of the request to its
+// context, to be recorded in item history. The actor of authenticated requests
+// is the authenticated subject, rather than the `X-Actor` header.
+func auditMiddleware(gc *gin.Context) {
+	audit := Audit{
+		Actor:     gc.GetHeader(HeaderActor),
+		RequestId: RequestIdFromContext(gc.Request.Context()),
+	}
+	if p := PrincipalFromContext(gc.Request.Context()); nil != p {
+		audit.Actor = p.Subject
 	}
+	gc.Request = gc.Request.WithContext(
+		newAuditContext(gc.Request.Context(), audit),
+	)
+	gc.Next()
 }
 
-func getEnvWithDefault(name, defVal string) string {
-	val := os.Getenv(name)
-	if "" == val {
-		return defVal
+// detachContext runs handlers with a copy of the gin context. Unlike the
+// original, the copy is not reused by gin once the request is finished, so it
+// can be safely held by database/sql goroutines and event streams.
+func detachContext(f StrictHandlerFunc, _ string) StrictHandlerFunc {
+	return func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return f(ctx.Copy(), request)
 	}
-	return val
 }
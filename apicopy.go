@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// defaultMaxCopySize is the default maximum number of items copied at once.
+const defaultMaxCopySize = 1000
+
+// CopyItem Copies a Item with its descendants
+// (POST /simple-tree/{id}/copy)
+func (s Server) CopyItem(
+	ctx context.Context, request CopyItemRequestObject,
+) (CopyItemResponseObject, error) {
+	body := request.Body
+	tx, err := s.EC.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if nil != err {
+			_ = tx.Rollback()
+		}
+	}()
+	err = s.authorize(ctx, tx.Client(), request.Id, schema.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	err = s.authorizeParent(ctx, tx.Client(), body.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	var source *ent.Item
+	source, err = tx.Item.Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if nil != body.ParentId {
+		var exists bool
+		exists, err = tx.Item.Query().Where(item.ID(*body.ParentId)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			err = ent.NewValidationError(
+				"parent_id", fmt.Errorf("parent %d not found", *body.ParentId),
+			)
+			return nil, err
+		}
+	}
+	children := map[uint32][]*ent.Item{}
+	if nil == body.Depth || *body.Depth > 0 {
+		// trashed descendants are left out with their subtrees, which have
+		// no copied parent to be attached to
+		var rows []*ent.Item
+		rows, err = tx.Item.Query().Order(item.ByID()).
+			QueryChildrenRecursive(request.Id).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			children[*row.ParentID] = append(children[*row.ParentID], row)
+		}
+	}
+	// copies are made level by level, so that parents are copied first
+	levels := [][]*ent.Item{{source}}
+	count := 1
+	for depth := 1; nil == body.Depth || depth <= *body.Depth; depth++ {
+		var next []*ent.Item
+		for _, parent := range levels[depth-1] {
+			next = append(next, children[parent.ID]...)
+		}
+		if 0 == len(next) {
+			break
+		}
+		levels = append(levels, next)
+		count += len(next)
+	}
+	if count > s.MaxCopySize {
+		err = ent.NewValidationError(
+			"depth", fmt.Errorf(
+				"copying %d items exceeds the limit of %d", count,
+				s.MaxCopySize,
+			),
+		)
+		return nil, err
+	}
+	name := source.Name
+	if nil != body.Suffix {
+		name += *body.Suffix
+	}
+	ids := map[string]uint32{}
+	parents := map[uint32]uint32{}
+	for depth, level := range levels {
+		builders := make([]*ent.ItemCreate, len(level))
+		for i, row := range level {
+			if 0 == depth {
+				builders[i] = tx.Item.Create().SetName(name).
+					SetNillableParentID(body.ParentId)
+			} else {
+				builders[i] = tx.Item.Create().SetName(row.Name).
+					SetParentID(parents[*row.ParentID])
+			}
+		}
+		var copies []*ent.Item
+		copies, err = tx.Item.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i, cp := range copies {
+			err = addEvent(ctx, tx, EventCreate, newItemEventData(cp))
+			if err != nil {
+				return nil, err
+			}
+			parents[level[i].ID] = cp.ID
+			ids[strconv.Itoa(int(level[i].ID))] = cp.ID
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.Outbox.Notify()
+	return CopyItem201JSONResponse{Id: parents[source.ID], Ids: ids}, nil
+}
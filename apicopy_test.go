@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// copyTree makes items 2 & 3 children of item 1, item 4 child of item 2,
+// item 5 child of item 4, and item 7 child of item 6, which is child of item
+// 3. Item 6 is trashed.
+var copyTree = map[uint32]uint32{2: 1, 3: 1, 4: 2, 5: 4, 6: 3, 7: 6}
+
+func copyItemOf(
+	t *testing.T, engine http.Handler, uri, body string,
+) CopyItem201JSONResponse {
+	res := serveRequest(engine, http.MethodPost, schema.BaseUri+uri, body)
+	assert.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	var actual CopyItem201JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	return actual
+}
+
+func Test_CopyItem_copies_subtree_under_parent(t *testing.T) {
+	engine, entClient := setupTree(t, copyTree, 6)
+	ctx := context.Background()
+	events := entClient.OutboxEvent.Query().CountX(ctx)
+	actual := copyItemOf(
+		t, engine, "/1/copy", `{"parent_id":10,"suffix":" (copy)"}`,
+	)
+	assert.Equal(t, uint32(51), actual.Id)
+	assert.Equal(
+		t, map[string]uint32{"1": 51, "2": 52, "3": 53, "4": 54, "5": 55},
+		actual.Ids,
+	)
+	rows := entClient.Item.Query().Where(item.IDGT(50)).Order(item.ByID()).
+		AllX(ctx)
+	assert.Len(t, rows, 5)
+	parents := map[uint32]uint32{}
+	for _, row := range rows {
+		parents[row.ID] = *row.ParentID
+	}
+	assert.Equal(
+		t, map[uint32]uint32{51: 10, 52: 51, 53: 51, 54: 52, 55: 54}, parents,
+	)
+	assert.Equal(t, "name 0 (copy)", rows[0].Name)
+	assert.Equal(t, "name 1", rows[1].Name)
+	assert.Equal(t, events+5, entClient.OutboxEvent.Query().CountX(ctx))
+	assert.Equal(
+		t, 1, entClient.ItemHistory.Query().
+			Where(itemhistory.ItemID(55)).CountX(ctx),
+	)
+}
+
+func Test_CopyItem_limits_depth(t *testing.T) {
+	engine, _ := setupTree(t, copyTree, 6)
+	actual := copyItemOf(t, engine, "/1/copy", `{"parent_id":10,"depth":1}`)
+	assert.Equal(
+		t, map[string]uint32{"1": 51, "2": 52, "3": 53}, actual.Ids,
+	)
+	actual = copyItemOf(t, engine, "/2/copy", `{"depth":0}`)
+	assert.Equal(t, map[string]uint32{"2": 54}, actual.Ids)
+}
+
+func Test_CopyItem_copies_as_root_without_parent(t *testing.T) {
+	engine, entClient := setupTree(t, copyTree, 6)
+	actual := copyItemOf(t, engine, "/4/copy", `{}`)
+	assert.Equal(t, map[string]uint32{"4": 51, "5": 52}, actual.Ids)
+	row := entClient.Item.GetX(context.Background(), 51)
+	assert.Nil(t, row.ParentID)
+	assert.Equal(t, "name 3", row.Name)
+}
+
+func Test_CopyItem_reports_errors(t *testing.T) {
+	engine, entClient := setupTree(t, copyTree, 6)
+	tests := []struct {
+		uri, body string
+		code      int
+	}{
+		{"/1000/copy", `{}`, http.StatusNotFound},
+		{"/6/copy", `{}`, http.StatusNotFound},
+		{"/1/copy", `{"parent_id":1000}`, http.StatusUnprocessableEntity},
+		{"/1/copy", `{"parent_id":6}`, http.StatusUnprocessableEntity},
+		{"/1/copy", `{"depth":-1}`, http.StatusBadRequest},
+		{"/1/copy", `{"name":"a"}`, http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		res := serveRequest(
+			engine, http.MethodPost, schema.BaseUri+test.uri, test.body,
+		)
+		assert.Equal(t, test.code, res.Code, test.body, res.Body.String())
+	}
+	assert.False(
+		t, entClient.Item.Query().Where(item.IDGT(50)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CopyItem_reports_422_over_size_limit(t *testing.T) {
+	t.Setenv("MAX_COPY_SIZE", "4")
+	engine, entClient := setupTree(t, copyTree, 6)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/1/copy", `{}`,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	assert.Contains(
+		t, res.Body.String(), "copying 5 items exceeds the limit of 4",
+	)
+	assert.False(
+		t, entClient.Item.Query().Where(item.IDGT(50)).
+			ExistX(context.Background()),
+	)
+	actual := copyItemOf(t, engine, "/1/copy", `{"depth":2}`)
+	assert.Len(t, actual.Ids, 4)
+}
+
+func Test_CopyItem_rolls_back_if_any_copy_fails(t *testing.T) {
+	engine, entClient := setupTree(t, copyTree, 6)
+	ctx := context.Background()
+	long := make([]byte, 254)
+	for i := range long {
+		long[i] = 'x'
+	}
+	entClient.Item.UpdateOneID(1).SetName(string(long)).ExecX(ctx)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/1/copy",
+		`{"suffix":" (copy)"}`,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	assert.False(t, entClient.Item.Query().Where(item.IDGT(50)).ExistX(ctx))
+}
+
+func Test_CopyItem_requires_write_permission_on_parent(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	entClient.Grant.Create().SetItemID(10).SetSubject("bob").
+		SetPermission(grant.PermissionWrite).SaveX(context.Background())
+	tests := []struct {
+		uri, body string
+		code      int
+	}{
+		{"/2/copy", `{"parent_id":10}`, http.StatusCreated},
+		{"/1/copy", `{"parent_id":10}`, http.StatusCreated},
+		{"/1/copy", `{"parent_id":11}`, http.StatusNotFound},
+		{"/1/copy", `{}`, http.StatusForbidden},
+		{"/11/copy", `{"parent_id":10}`, http.StatusNotFound},
+	}
+	for _, test := range tests {
+		res := serveRequest(
+			engine, http.MethodPost, schema.BaseUri+test.uri, test.body,
+			withHeader(HeaderApiKey, "bob-key"),
+		)
+		assert.Equal(t, test.code, res.Code, test.uri, test.body)
+	}
+}
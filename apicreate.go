@@ -29,6 +29,10 @@ func (s Server) CreateItem(
 			_ = tx.Rollback()
 		}
 	}()
+	err = s.authorizeParent(ctx, tx.Client(), request.Body.ParentId)
+	if err != nil {
+		return nil, err
+	}
 	ac := tx.Item.Create()
 	ac.SetName(request.Body.Name)
 	if request.Body.ParentId != nil {
@@ -39,10 +43,15 @@ func (s Server) CreateItem(
 	if err != nil {
 		return nil, err
 	}
+	err = addEvent(ctx, tx, EventCreate, newItemEventData(aa))
+	if err != nil {
+		return nil, err
+	}
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+	s.Outbox.Notify()
 
 	var pid *uint32
 	if nil == aa.ParentID {
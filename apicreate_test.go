@@ -15,7 +15,7 @@ import (
 
 func Test_CreateItem_creates_new_record(t *testing.T) {
 	_, engine, entClient, res := setupGinTest(t)
-	body := `{"name":"test name","abbr":"test abbr","parent_id":1}`
+	body := `{"name":"test name","parent_id":1}`
 	req, _ := http.NewRequest(
 		http.MethodPost, schema.BaseUri, io.NopCloser(strings.NewReader(body)),
 	)
@@ -40,12 +40,13 @@ func Test_CreateItem_creates_new_record(t *testing.T) {
 	assert.JSONEq(t, expected, actual)
 }
 
-func Test_CreateItem_422(t *testing.T) {
+func Test_CreateItem_400(t *testing.T) {
 	_, engine, _, res := setupGinTest(t)
 	body := `{"name":"a"}`
 	req, _ := http.NewRequest(
 		http.MethodPost, schema.BaseUri, io.NopCloser(strings.NewReader(body)),
 	)
 	engine.ServeHTTP(res, req)
-	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), `"field":"name"`)
 }
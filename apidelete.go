@@ -5,7 +5,8 @@ import (
 
 	"github.com/eidng8/go-ent/softdelete"
 
-	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
 // DeleteItem Deletes a Item by ID
@@ -23,14 +24,36 @@ func (s Server) DeleteItem(
 			_ = tx.Rollback()
 		}
 	}()
+	purge := nil != request.Params.Trashed && *request.Params.Trashed
+	permission := schema.PermissionWrite
+	if purge {
+		permission = schema.PermissionAdmin
+	}
+	err = s.authorize(qc, tx.Client(), request.Id, permission)
+	if err != nil {
+		return nil, err
+	}
 	if err = tx.Item.DeleteOneID(request.Id).Exec(qc); err != nil {
-		if ent.IsNotFound(err) {
-			return DeleteItem404JSONResponse{}, nil
-		}
 		return nil, err
 	}
+	err = addEvent(
+		qc, tx, EventDelete, ItemEventData{
+			Id:     request.Id,
+			Purged: purge,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if purge {
+		_, err = tx.Grant.Delete().Where(grant.ItemID(request.Id)).Exec(qc)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
+	s.Outbox.Notify()
 	return DeleteItem204Response{}, nil
 }
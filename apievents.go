@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// eventKeepAlive is the interval of comments sent to keep idle streams open.
+const eventKeepAlive = 15 * time.Second
+
+type streamItemEventsResponse struct {
+	ctx      context.Context
+	log      *EventLog
+	backlog  []Event
+	ch       chan Event
+	complete bool
+	// visible filters events of items the principal cannot read, nil if all
+	// events are visible.
+	visible func(Event) bool
+}
+
+func (response streamItemEventsResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	defer response.log.Unsubscribe(response.ch)
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming is not supported by the response writer")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(200)
+	if !response.complete {
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: {}\n\n", EventReset); err != nil {
+			return err
+		}
+	}
+	for _, event := range response.backlog {
+		if err := response.writeEvent(w, event); err != nil {
+			return err
+		}
+	}
+	flusher.Flush()
+	ticker := time.NewTicker(eventKeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-response.ctx.Done():
+			return nil
+		case event, ok := <-response.ch:
+			if !ok {
+				// fell behind, let the client reconnect with `Last-Event-ID`
+				return nil
+			}
+			if err := response.writeEvent(w, event); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func (response streamItemEventsResponse) writeEvent(
+	w http.ResponseWriter, event Event,
+) error {
+	if nil != response.visible && !response.visible(event) {
+		return nil
+	}
+	b, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// StreamItemEvents Stream Item change events
+// (GET /simple-tree/events)
+func (s Server) StreamItemEvents(
+	ctx context.Context, request StreamItemEventsRequestObject,
+) (StreamItemEventsResponseObject, error) {
+	var lastId uint64
+	if nil != request.Params.LastEventID {
+		var err error
+		lastId, err = strconv.ParseUint(*request.Params.LastEventID, 10, 64)
+		if err != nil {
+			return nil, newApiError(
+				http.StatusBadRequest, err, []FieldError{{
+					In: "header", Field: "Last-Event-ID",
+					Message: "must be an event ID",
+				}},
+			)
+		}
+	}
+	var visible func(Event) bool
+	if !s.isSuperuser(ctx) {
+		// purged items are no longer in the tree, so their events are only
+		// visible to superusers
+		subject := PrincipalFromContext(ctx).Subject
+		visible = func(event Event) bool {
+			return slices.Contains(event.readers, subject)
+		}
+	}
+	backlog, ch, complete := s.Events.Subscribe(lastId)
+	return streamItemEventsResponse{
+		ctx:      ctx,
+		log:      s.Events,
+		backlog:  backlog,
+		ch:       ch,
+		complete: complete,
+		visible:  visible,
+	}, nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+type sseEvent struct {
+	id, event, data string
+}
+
+// openEventStream connects to the event stream of the given server.
+func openEventStream(
+	tb testing.TB, url string, lastEventId string, options ...requestOption,
+) *bufio.Reader {
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(
+		ctx, http.MethodGet, url+schema.BaseUri+"/events", nil,
+	)
+	if "" != lastEventId {
+		req.Header.Set("Last-Event-ID", lastEventId)
+	}
+	for _, option := range options {
+		option(req)
+	}
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(tb, err)
+	tb.Cleanup(
+		func() {
+			cancel()
+			_ = res.Body.Close()
+		},
+	)
+	assert.Equal(tb, http.StatusOK, res.StatusCode)
+	assert.Equal(tb, "text/event-stream", res.Header.Get("Content-Type"))
+	return bufio.NewReader(res.Body)
+}
+
+// readEvents reads the given number of events from the stream.
+func readEvents(tb testing.TB, r *bufio.Reader, n int) []sseEvent {
+	var events []sseEvent
+	var event sseEvent
+	for len(events) < n {
+		line, err := r.ReadString('\n')
+		assert.Nil(tb, err)
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case "" == line:
+			events = append(events, event)
+			event = sseEvent{}
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	return events
+}
+
+func Test_StreamItemEvents_streams_changes(t *testing.T) {
+	server, engine, _, _ := setupGinTest(t)
+	ts := httptest.NewServer(engine)
+	t.Cleanup(ts.Close)
+	stream := openEventStream(t, ts.URL, "")
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"test name"}`,
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	res = serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/51", `{"name":"new name"}`,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/51", `{"parent_id":1}`,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodDelete, schema.BaseUri+"/51", "")
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	res = serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/51/restore", "",
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	res = serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/51?trashed=1", "",
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	assert.Nil(t, server.Outbox.RelayPending(context.Background()))
+	assert.Equal(
+		t, []sseEvent{
+			{"1", EventCreate, `{"id":51,"name":"test name"}`},
+			{"2", EventUpdate, `{"id":51,"name":"new name"}`},
+			{"3", EventMove, `{"id":51,"parent_id":1,"name":"new name"}`},
+			{"4", EventDelete, `{"id":51}`},
+			{"5", EventRestore, `{"id":51,"parent_id":1,"name":"new name"}`},
+			{"6", EventDelete, `{"id":51,"purged":true}`},
+		},
+		readEvents(t, stream, 6),
+	)
+}
+
+func Test_StreamItemEvents_resumes_from_last_event_id(t *testing.T) {
+	server, engine, _, _ := setupGinTest(t)
+	ts := httptest.NewServer(engine)
+	t.Cleanup(ts.Close)
+	for _, name := range []string{"name a", "name b", "name c"} {
+		res := serveRequest(
+			engine, http.MethodPatch, schema.BaseUri+"/2",
+			`{"name":"`+name+`"}`,
+		)
+		assert.Equal(t, http.StatusOK, res.Code)
+	}
+	assert.Nil(t, server.Outbox.RelayPending(context.Background()))
+	stream := openEventStream(t, ts.URL, "1")
+	assert.Equal(
+		t, []sseEvent{
+			{"2", EventUpdate, `{"id":2,"name":"name b"}`},
+			{"3", EventUpdate, `{"id":2,"name":"name c"}`},
+		},
+		readEvents(t, stream, 2),
+	)
+}
+
+func Test_StreamItemEvents_sends_reset_if_events_dropped(t *testing.T) {
+	t.Setenv("EVENT_LOG_SIZE", "1")
+	server, engine, _, _ := setupGinTest(t)
+	ts := httptest.NewServer(engine)
+	t.Cleanup(ts.Close)
+	for _, name := range []string{"name a", "name b", "name c"} {
+		res := serveRequest(
+			engine, http.MethodPatch, schema.BaseUri+"/2",
+			`{"name":"`+name+`"}`,
+		)
+		assert.Equal(t, http.StatusOK, res.Code)
+	}
+	assert.Nil(t, server.Outbox.RelayPending(context.Background()))
+	stream := openEventStream(t, ts.URL, "1")
+	assert.Equal(
+		t, []sseEvent{
+			{"", EventReset, `{}`},
+			{"3", EventUpdate, `{"id":2,"name":"name c"}`},
+		},
+		readEvents(t, stream, 2),
+	)
+}
+
+func Test_StreamItemEvents_reports_400_if_last_event_id_invalid(t *testing.T) {
+	_, engine, _, res := setupGinTest(t)
+	req, _ := http.NewRequest(http.MethodGet, schema.BaseUri+"/events", nil)
+	req.Header.Set("Last-Event-ID", "abc")
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_StreamItemEvents_keeps_events_of_failed_transactions_out(t *testing.T) {
+	server, engine, entClient, _ := setupGinTest(t)
+	ts := httptest.NewServer(engine)
+	t.Cleanup(ts.Close)
+	stream := openEventStream(t, ts.URL, "")
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/2", `{"parent_id":2}`,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	res = serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/2", `{"name":"new name"}`,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(
+		t, 1, entClient.OutboxEvent.Query().CountX(context.Background()),
+	)
+	assert.Nil(t, server.Outbox.RelayPending(context.Background()))
+	assert.Equal(
+		t, []sseEvent{{"1", EventUpdate, `{"id":2,"name":"new name"}`}},
+		readEvents(t, stream, 1),
+	)
+}
+
+func Test_StreamItemEvents_sends_events_of_readable_items_only(t *testing.T) {
+	server, engine, entClient := setupAuthzTest(t)
+	ts := httptest.NewServer(engine)
+	t.Cleanup(ts.Close)
+	streams := []*bufio.Reader{
+		openEventStream(t, ts.URL, "", withHeader(HeaderApiKey, "bob-key")),
+		openEventStream(t, ts.URL, "", withHeader(HeaderApiKey, "bob-key")),
+	}
+	for _, id := range []string{"5", "4"} {
+		res := serveRequest(
+			engine, http.MethodPatch, schema.BaseUri+"/"+id,
+			`{"name":"new name"}`, withHeader(HeaderApiKey, "root-key"),
+		)
+		assert.Equal(t, http.StatusOK, res.Code)
+	}
+	var queries atomic.Int32
+	entClient.Grant.Intercept(
+		ent.InterceptFunc(
+			func(next ent.Querier) ent.Querier {
+				return ent.QuerierFunc(
+					func(ctx context.Context, q ent.Query) (ent.Value, error) {
+						queries.Add(1)
+						return next.Query(ctx, q)
+					},
+				)
+			},
+		),
+	)
+	assert.Nil(t, server.Outbox.RelayPending(context.Background()))
+	for _, stream := range streams {
+		assert.Equal(
+			t, []sseEvent{
+				{"2", EventUpdate, `{"id":4,"parent_id":2,"name":"new name"}`},
+			},
+			readEvents(t, stream, 1),
+		)
+	}
+	// grants are queried once for each event, not for each subscriber
+	assert.Equal(t, int32(2), queries.Load())
+}
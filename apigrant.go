@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// ListItemGrant List grants of an Item
+// (GET /simple-tree/{id}/grants)
+func (s Server) ListItemGrant(
+	ctx context.Context, request ListItemGrantRequestObject,
+) (ListItemGrantResponseObject, error) {
+	err := s.authorize(ctx, s.EC, request.Id, schema.PermissionAdmin)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := s.EC.Item.Query().Where(item.ID(request.Id)).
+		Exist(softdelete.IncludeTrashed(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("item %d %w", request.Id, errNotFound)
+	}
+	rows, err := s.EC.Grant.Query().Where(grant.ItemID(request.Id)).
+		Order(grant.BySubject()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grants := make(ListItemGrant200JSONResponse, len(rows))
+	for i, row := range rows {
+		grants[i] = newGrant(row)
+	}
+	return grants, nil
+}
+
+// SetItemGrant Grants a subject permission on an Item
+// (PUT /simple-tree/{id}/grants/{subject})
+func (s Server) SetItemGrant(
+	ctx context.Context, request SetItemGrantRequestObject,
+) (SetItemGrantResponseObject, error) {
+	tx, err := s.EC.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if nil != err {
+			_ = tx.Rollback()
+		}
+	}()
+	err = s.authorize(ctx, tx.Client(), request.Id, schema.PermissionAdmin)
+	if err != nil {
+		return nil, err
+	}
+	var exists bool
+	exists, err = tx.Item.Query().Where(item.ID(request.Id)).
+		Exist(softdelete.IncludeTrashed(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		err = fmt.Errorf("item %d %w", request.Id, errNotFound)
+		return nil, err
+	}
+	permission := grant.Permission(request.Body.Permission)
+	var row *ent.Grant
+	row, err = tx.Grant.Query().
+		Where(grant.ItemID(request.Id), grant.Subject(request.Subject)).
+		Only(ctx)
+	switch {
+	case nil == err:
+		row, err = tx.Grant.UpdateOne(row).SetPermission(permission).Save(ctx)
+	case ent.IsNotFound(err):
+		row, err = tx.Grant.Create().
+			SetItemID(request.Id).
+			SetSubject(request.Subject).
+			SetPermission(permission).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return SetItemGrant200JSONResponse(newGrant(row)), nil
+}
+
+// DeleteItemGrant Revokes the grant of a subject on an Item
+// (DELETE /simple-tree/{id}/grants/{subject})
+func (s Server) DeleteItemGrant(
+	ctx context.Context, request DeleteItemGrantRequestObject,
+) (DeleteItemGrantResponseObject, error) {
+	err := s.authorize(ctx, s.EC, request.Id, schema.PermissionAdmin)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.EC.Grant.Delete().
+		Where(grant.ItemID(request.Id), grant.Subject(request.Subject)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if 0 == n {
+		return nil, fmt.Errorf(
+			"grant of %s on item %d %w", request.Subject, request.Id,
+			errNotFound,
+		)
+	}
+	return DeleteItemGrant204Response{}, nil
+}
+
+func newGrant(row *ent.Grant) Grant {
+	return Grant{
+		Id:         row.ID,
+		ItemId:     row.ItemID,
+		Subject:    row.Subject,
+		Permission: Permission(row.Permission),
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_ListItemGrant_lists_grants_of_item(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2/grants", "",
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ListItemGrant200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "bob", actual[0].Subject)
+	assert.Equal(t, Write, actual[0].Permission)
+}
+
+func Test_ListItemGrant_requires_admin_permission(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2/grants", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2/grants", "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ListItemGrant_reports_404_if_item_not_found(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1000/grants", "",
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_SetItemGrant_creates_and_updates_grants(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodPut, schema.BaseUri+"/5/grants/eve",
+		`{"permission":"read"}`, withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual SetItemGrant200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, uint32(5), actual.ItemId)
+	assert.Equal(t, Read, actual.Permission)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/5", "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodPut, schema.BaseUri+"/5/grants/eve",
+		`{"permission":"admin"}`, withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	row := entClient.Grant.Query().
+		Where(grant.ItemID(5), grant.Subject("eve")).
+		OnlyX(context.Background())
+	assert.Equal(t, actual.Id, row.ID)
+	assert.Equal(t, grant.PermissionAdmin, row.Permission)
+}
+
+func Test_SetItemGrant_allows_subtree_administrators(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	entClient.Grant.Update().Where(grant.ItemID(2)).
+		SetPermission(grant.PermissionAdmin).SaveX(context.Background())
+	res := serveRequest(
+		engine, http.MethodPut, schema.BaseUri+"/4/grants/eve",
+		`{"permission":"write"}`, withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodPut, schema.BaseUri+"/3/grants/eve",
+		`{"permission":"write"}`, withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_SetItemGrant_reports_400_if_permission_invalid(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodPut, schema.BaseUri+"/5/grants/eve",
+		`{"permission":"owner"}`, withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), `"field":"permission"`)
+}
+
+func Test_DeleteItemGrant_revokes_grant(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/1/grants/bob", "",
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	assert.False(
+		t, entClient.Grant.Query().Where(grant.ItemID(1)).
+			ExistX(context.Background()),
+	)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/3", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	res = serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/1/grants/bob", "",
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -5,19 +5,25 @@ import (
 	"net/http"
 	"unicode/utf8"
 
-	"github.com/eidng8/go-ent/softdelete"
 	"github.com/gin-gonic/gin"
 
 	"github.com/eidng8/go-ent/paginate"
 
 	"github.com/eidng8/go-simple-tree/ent"
 	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
 )
 
-type ListItemPaginatedResponse struct {
-	*paginate.PaginatedList[ent.Item]
+// itemPageResponse is the page of items answered by list operations, linked
+// to adjacent pages by cursors if paginated by cursor.
+type itemPageResponse struct {
+	*paginate.PaginatedList[ItemList]
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
+type ListItemPaginatedResponse itemPageResponse
+
 func (response ListItemPaginatedResponse) VisitListItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
@@ -30,11 +36,43 @@ func (s Server) ListItem(
 	ctx context.Context, request ListItemRequestObject,
 ) (ListItemResponseObject, error) {
 	gc := ctx.(*gin.Context)
-	query := s.EC.Item.Query().Order(item.ByID())
-	qc := softdelete.NewSoftDeleteQueryContext(request.Params.Trashed, ctx)
-	applyNameFilter(request, query)
+	view := newItemView(request.Params.Fields, request.Params.Expand)
+	err := view.checkAsOf(request.Params.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	if nil != request.Params.AsOf {
+		return s.getSnapshotPage(gc, ctx, view, request)
+	}
+	query := s.EC.Item.Query().Where(s.readable(ctx, item.FieldID))
+	qc := trashedContext(ctx, request.Params.Trashed)
+	applyTrashedFilter(request.Params.Trashed, query)
+	applyNameFilter(request.Params.Name, query)
+	s.eagerLoad(ctx, query, view)
+	page, err := getItemPage(
+		gc, qc, s.BaseURL, query, request.Params.Pagination,
+		request.Params.Cursor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s.newListItemResponse(qc, view, page)
+}
+
+// getItemPage returns the page of items of the query, paginated by cursor or
+// by offset as requested.
+func getItemPage[P ~string](
+	gc *gin.Context, qc context.Context, baseUrl string, query *ent.ItemQuery,
+	pagination *P, cursor *string,
+) (*cursorPage[ent.Item], error) {
+	if cursorPaginated(pagination, cursor) {
+		return getCursorPage(
+			gc, baseUrl, cursor, itemKeyset(qc, query), itemKey,
+		)
+	}
+	query.Order(item.ByID())
 	paginator := paginate.Paginator[ent.Item, ent.ItemQuery]{
-		BaseUrl:  s.BaseURL,
+		BaseUrl:  baseUrl,
 		Query:    query,
 		GinCtx:   gc,
 		QueryCtx: qc,
@@ -43,13 +81,71 @@ func (s Server) ListItem(
 	if err != nil {
 		return nil, err
 	}
-	return ListItemPaginatedResponse{PaginatedList: areas}, nil
+	return &cursorPage[ent.Item]{PaginatedList: areas}, nil
+}
+
+func (s Server) getSnapshotPage(
+	gc *gin.Context, qc context.Context, view itemView,
+	request ListItemRequestObject,
+) (ListItemResponseObject, error) {
+	query := s.snapshotQuery(*request.Params.AsOf, request.Params.Trashed).
+		Where(s.readable(qc, itemhistory.FieldItemID))
+	applySnapshotNameFilter(request.Params.Name, query)
+	if cursorPaginated(request.Params.Pagination, request.Params.Cursor) {
+		page, err := getCursorPage(
+			gc, s.BaseURL, request.Params.Cursor, snapshotKeyset(qc, query),
+			snapshotKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return s.newListItemResponse(qc, view, mapSnapshotCursorPage(page))
+	}
+	query.Order(itemhistory.ByItemID())
+	paginator := paginate.Paginator[ent.ItemHistory, ent.ItemHistoryQuery]{
+		BaseUrl:  s.BaseURL,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: qc,
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		return nil, err
+	}
+	return s.newListItemResponse(
+		qc, view, &cursorPage[ent.Item]{PaginatedList: mapSnapshotPage(page)},
+	)
+}
+
+// newItemPageResponse returns the page of items in the view.
+func (s Server) newItemPageResponse(
+	qc context.Context, view itemView, page *cursorPage[ent.Item],
+) (itemPageResponse, error) {
+	list, err := s.newItemPage(qc, view, page.PaginatedList)
+	if err != nil {
+		return itemPageResponse{}, err
+	}
+	return itemPageResponse{
+		PaginatedList: list,
+		NextCursor:    page.NextCursor,
+		PrevCursor:    page.PrevCursor,
+	}, nil
+}
+
+// newListItemResponse answers ListItem with the page of items in the view.
+func (s Server) newListItemResponse(
+	qc context.Context, view itemView, page *cursorPage[ent.Item],
+) (ListItemResponseObject, error) {
+	res, err := s.newItemPageResponse(qc, view, page)
+	if err != nil {
+		return nil, err
+	}
+	return ListItemPaginatedResponse(res), nil
 }
 
-func applyNameFilter(
-	request ListItemRequestObject, query *ent.ItemQuery,
-) {
-	name := request.Params.Name
+// applyNameFilter leaves out items whose names don't start with the given
+// prefix of at least two characters.
+func applyNameFilter(name *string, query *ent.ItemQuery) {
 	if name != nil && utf8.RuneCountInString(*name) > 1 {
 		query.Where(item.NameHasPrefix(*name))
 	}
@@ -3,8 +3,9 @@ package main
 import (
 	"context"
 	"net/http"
-	"regexp"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-ent/paginate"
 	"github.com/eidng8/go-ent/softdelete"
@@ -89,10 +90,10 @@ func Test_ListItem_should_return_all_records(t *testing.T) {
 	}
 	page := paginate.PaginatedList[Item]{
 		Total:        50,
-		PerPage:      12345,
+		PerPage:      255,
 		CurrentPage:  1,
 		LastPage:     1,
-		FirstPageUrl: server.BaseUrl() + "?page=1&per_page=12345",
+		FirstPageUrl: server.BaseUrl() + "?page=1&per_page=255",
 		LastPageUrl:  "",
 		NextPageUrl:  "",
 		PrevPageUrl:  "",
@@ -105,7 +106,7 @@ func Test_ListItem_should_return_all_records(t *testing.T) {
 	assert.Nil(t, err)
 	expected := string(bytes)
 	req, _ := http.NewRequest(
-		http.MethodGet, schema.BaseUri+"?per_page=12345", nil,
+		http.MethodGet, schema.BaseUri+"?per_page=255", nil,
 	)
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusOK, res.Code)
@@ -158,11 +159,12 @@ func Test_ListItem_should_return_2nd_page_include_deleted(t *testing.T) {
 		Where(item.IDLTE(20)).
 		Offset(10).Limit(10).
 		AllX(softdelete.IncludeTrashed(context.Background()))
-	list := make([]*Item, len(rows))
+	list := make([]*ItemList, len(rows))
 	for i, row := range rows {
-		list[i] = newItemFromEnt(row)
+		aa := newItemListFromEnt(row)
+		list[i] = &aa
 	}
-	page := paginate.PaginatedList[Item]{
+	page := paginate.PaginatedList[ItemList]{
 		Total:        50,
 		PerPage:      10,
 		CurrentPage:  2,
@@ -185,8 +187,7 @@ func Test_ListItem_should_return_2nd_page_include_deleted(t *testing.T) {
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusOK, res.Code)
 	actual := res.Body.String()
-	re := regexp.MustCompile(`"deleted_at":"[^"]*",`)
-	actual = re.ReplaceAllString(actual, "")
+	assert.Contains(t, actual, `"deleted_at":`)
 	assert.JSONEq(t, expected, actual)
 }
 
@@ -204,10 +205,10 @@ func Test_ListItem_should_return_all_records_exclude_deleted(t *testing.T) {
 	}
 	page := paginate.PaginatedList[Item]{
 		Total:        47,
-		PerPage:      12345,
+		PerPage:      255,
 		CurrentPage:  1,
 		LastPage:     1,
-		FirstPageUrl: server.BaseUrl() + "?page=1&per_page=12345",
+		FirstPageUrl: server.BaseUrl() + "?page=1&per_page=255",
 		LastPageUrl:  "",
 		NextPageUrl:  "",
 		PrevPageUrl:  "",
@@ -220,7 +221,7 @@ func Test_ListItem_should_return_all_records_exclude_deleted(t *testing.T) {
 	assert.Nil(t, err)
 	expected := string(bytes)
 	req, _ := http.NewRequest(
-		http.MethodGet, schema.BaseUri+"?per_page=12345",
+		http.MethodGet, schema.BaseUri+"?per_page=255",
 		nil,
 	)
 	engine.ServeHTTP(res, req)
@@ -379,3 +380,34 @@ func Test_ListItem_should_report_400_for_invalid_perPage(t *testing.T) {
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusBadRequest, res.Code)
 }
+
+func Test_ListItem_should_return_records_as_of_given_time(t *testing.T) {
+	server, engine, entClient, res := setupGinTest(t)
+	backdateHistory(t, entClient, time.Hour)
+	entClient.Item.UpdateOneID(2).SetName("new name").
+		ExecX(context.Background())
+	entClient.Item.DeleteOneID(1).ExecX(context.Background())
+	asOf := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	req, _ := http.NewRequest(
+		http.MethodGet, schema.BaseUri+"?as_of="+url.QueryEscape(asOf), nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ListItem200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, 50, actual.Total)
+	assert.Equal(t, server.BaseUrl(), actual.Path)
+	assert.Len(t, actual.Data, 10)
+	assert.Equal(t, uint32(1), actual.Data[0].Id)
+	assert.Equal(t, "name 1", actual.Data[1].Name)
+}
+
+func Test_ListItem_reports_400_if_per_page_over_maximum(t *testing.T) {
+	_, engine, _, res := setupGinTest(t)
+	req, _ := http.NewRequest(
+		http.MethodGet, schema.BaseUri+"?per_page=12345", nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), `"field":"per_page"`)
+}
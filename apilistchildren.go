@@ -3,7 +3,6 @@ package main
 import (
 	"context"
 	"net/http"
-	"unicode/utf8"
 
 	"github.com/eidng8/go-ent/paginate"
 	"github.com/eidng8/go-utils"
@@ -11,11 +10,10 @@ import (
 
 	"github.com/eidng8/go-simple-tree/ent"
 	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
 )
 
-type ListItemChildrenPaginatedResponse struct {
-	*paginate.PaginatedList[ent.Item]
-}
+type ListItemChildrenPaginatedResponse itemPageResponse
 
 func (response ListItemChildrenPaginatedResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -29,39 +27,60 @@ func (s Server) ListItemChildren(
 	ctx context.Context, request ListItemChildrenRequestObject,
 ) (ListItemChildrenResponseObject, error) {
 	gc := ctx.(*gin.Context)
-	query := s.EC.Item.Query().Order(item.ByID())
-	applyChildrenNameFilter(request, query)
 	id := request.Id
-	if nil != request.Params.Recurse && *request.Params.Recurse {
-		return s.getDescendants(gc, ctx, query, id)
+	recurse := nil != request.Params.Recurse && *request.Params.Recurse
+	view := newItemView(request.Params.Fields, request.Params.Expand)
+	err := view.checkAsOf(request.Params.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	if nil != request.Params.AsOf {
+		if recurse {
+			return s.getSnapshotDescendants(gc, ctx, view, request)
+		}
+		return s.getSnapshotChildrenPage(gc, ctx, view, request)
+	}
+	// branches the principal cannot read are left out
+	query := s.EC.Item.Query().Where(s.readable(ctx, item.FieldID))
+	qc := trashedContext(ctx, request.Params.Trashed)
+	applyTrashedFilter(request.Params.Trashed, query)
+	applyNameFilter(request.Params.Name, query)
+	s.eagerLoad(ctx, query, view)
+	if recurse {
+		return s.getDescendants(gc, qc, query, view, id)
 	}
-	return s.getChildrenPage(gc, ctx, query, id)
+	return s.getChildrenPage(gc, qc, query, view, request)
 }
 
 func (s Server) getChildrenPage(
-	gc *gin.Context, qc context.Context, query *ent.ItemQuery, id uint32,
+	gc *gin.Context, qc context.Context, query *ent.ItemQuery, view itemView,
+	request ListItemChildrenRequestObject,
 ) (ListItemChildrenResponseObject, error) {
-	query.Where(item.HasParentWith(item.ID(id)))
-	paginator := paginate.Paginator[ent.Item, ent.ItemQuery]{
-		BaseUrl:  s.BaseURL,
-		Query:    query,
-		GinCtx:   gc,
-		QueryCtx: qc,
-	}
-	areas, err := paginator.GetPage()
+	query.Where(item.HasParentWith(item.ID(request.Id)))
+	page, err := getItemPage(
+		gc, qc, s.BaseURL, query, request.Params.Pagination,
+		request.Params.Cursor,
+	)
 	if err != nil {
 		return nil, err
 	}
-	return ListItemChildrenPaginatedResponse{PaginatedList: areas}, nil
+	return s.newListItemChildrenResponse(qc, view, page)
 }
 
 func (s Server) getDescendants(
-	gc *gin.Context, qc context.Context, query *ent.ItemQuery, id uint32,
+	gc *gin.Context, qc context.Context, query *ent.ItemQuery, view itemView,
+	id uint32,
 ) (ListItemChildrenResponseObject, error) {
-	areas, err := query.QueryChildrenRecursive(id).All(qc)
+	areas, err := query.Order(item.ByID()).QueryChildrenRecursive(id).All(qc)
 	if err != nil {
 		return nil, err
 	}
+	return s.newDescendantsResponse(gc, qc, view, areas)
+}
+
+func (s Server) newDescendantsResponse(
+	gc *gin.Context, qc context.Context, view itemView, areas []*ent.Item,
+) (ListItemChildrenResponseObject, error) {
 	count := len(areas)
 	req := gc.Request
 	paginator := paginate.Paginator[ent.Item, ent.ItemQuery]{
@@ -72,29 +91,90 @@ func (s Server) getDescendants(
 	}
 	u := paginator.UrlWithoutPageParams()
 	u = utils.UrlWithQueryParam(*u, "recurse", "1")
-	return ListItemChildrenPaginatedResponse{
-		PaginatedList: &paginate.PaginatedList[ent.Item]{
-			CurrentPage:  1,
-			FirstPageUrl: u.String(),
-			From:         1,
-			LastPage:     1,
-			LastPageUrl:  "",
-			NextPageUrl:  "",
-			Path:         utils.RequestBaseUrl(req).String(),
-			PerPage:      count,
-			PrevPageUrl:  "",
-			To:           count,
-			Total:        count,
-			Data:         areas,
+	return s.newListItemChildrenResponse(
+		qc, view, &cursorPage[ent.Item]{
+			PaginatedList: &paginate.PaginatedList[ent.Item]{
+				CurrentPage:  1,
+				FirstPageUrl: u.String(),
+				From:         1,
+				LastPage:     1,
+				LastPageUrl:  "",
+				NextPageUrl:  "",
+				Path:         utils.RequestBaseUrl(req).String(),
+				PerPage:      count,
+				PrevPageUrl:  "",
+				To:           count,
+				Total:        count,
+				Data:         areas,
+			},
 		},
-	}, nil
+	)
+}
+
+func (s Server) getSnapshotChildrenPage(
+	gc *gin.Context, qc context.Context, view itemView,
+	request ListItemChildrenRequestObject,
+) (ListItemChildrenResponseObject, error) {
+	query := s.snapshotQuery(*request.Params.AsOf, request.Params.Trashed).
+		Where(
+			itemhistory.ParentAfter(request.Id),
+			s.readable(qc, itemhistory.FieldItemID),
+		)
+	applySnapshotNameFilter(request.Params.Name, query)
+	if cursorPaginated(request.Params.Pagination, request.Params.Cursor) {
+		page, err := getCursorPage(
+			gc, s.BaseURL, request.Params.Cursor, snapshotKeyset(qc, query),
+			snapshotKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return s.newListItemChildrenResponse(
+			qc, view, mapSnapshotCursorPage(page),
+		)
+	}
+	query.Order(itemhistory.ByItemID())
+	paginator := paginate.Paginator[ent.ItemHistory, ent.ItemHistoryQuery]{
+		BaseUrl:  s.BaseURL,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: qc,
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		return nil, err
+	}
+	return s.newListItemChildrenResponse(
+		qc, view, &cursorPage[ent.Item]{PaginatedList: mapSnapshotPage(page)},
+	)
 }
 
-func applyChildrenNameFilter(
-	request ListItemChildrenRequestObject, query *ent.ItemQuery,
-) {
-	name := request.Params.Name
-	if name != nil && utf8.RuneCountInString(*name) > 1 {
-		query.Where(item.NameHasPrefix(*name))
+func (s Server) getSnapshotDescendants(
+	gc *gin.Context, qc context.Context, view itemView,
+	request ListItemChildrenRequestObject,
+) (ListItemChildrenResponseObject, error) {
+	areas, err := s.snapshotDescendants(
+		qc, *request.Params.AsOf, request.Params.Name, request.Params.Trashed,
+		request.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	areas, err = s.filterReadable(qc, areas)
+	if err != nil {
+		return nil, err
+	}
+	return s.newDescendantsResponse(gc, qc, view, areas)
+}
+
+// newListItemChildrenResponse is the children counterpart of
+// newListItemResponse.
+func (s Server) newListItemChildrenResponse(
+	qc context.Context, view itemView, page *cursorPage[ent.Item],
+) (ListItemChildrenResponseObject, error) {
+	res, err := s.newItemPageResponse(qc, view, page)
+	if err != nil {
+		return nil, err
 	}
+	return ListItemChildrenPaginatedResponse(res), nil
 }
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-ent/paginate"
 	"github.com/stretchr/testify/assert"
@@ -97,10 +99,10 @@ func Test_ListItemChildren_should_return_all_records(t *testing.T) {
 	}
 	page := paginate.PaginatedList[Item]{
 		Total:        48,
-		PerPage:      12345,
+		PerPage:      255,
 		CurrentPage:  1,
 		LastPage:     1,
-		FirstPageUrl: server.BaseUrl() + "/2/children?page=1&per_page=12345",
+		FirstPageUrl: server.BaseUrl() + "/2/children?page=1&per_page=255",
 		LastPageUrl:  "",
 		NextPageUrl:  "",
 		PrevPageUrl:  "",
@@ -114,7 +116,7 @@ func Test_ListItemChildren_should_return_all_records(t *testing.T) {
 	expected := string(bytes)
 	req, _ := http.NewRequest(
 		http.MethodGet,
-		server.BaseUrl()+"/2/children?per_page=12345", nil,
+		server.BaseUrl()+"/2/children?per_page=255", nil,
 	)
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusOK, res.Code)
@@ -179,10 +181,10 @@ func Test_ListItemChildren_should_return_all_records_exclude_deleted(t *testing.
 	}
 	page := paginate.PaginatedList[Item]{
 		Total:        45,
-		PerPage:      12345,
+		PerPage:      255,
 		CurrentPage:  1,
 		LastPage:     1,
-		FirstPageUrl: server.BaseUrl() + "/2/children?page=1&per_page=12345",
+		FirstPageUrl: server.BaseUrl() + "/2/children?page=1&per_page=255",
 		LastPageUrl:  "",
 		NextPageUrl:  "",
 		PrevPageUrl:  "",
@@ -196,7 +198,7 @@ func Test_ListItemChildren_should_return_all_records_exclude_deleted(t *testing.
 	expected := string(bytes)
 	req, _ := http.NewRequest(
 		http.MethodGet,
-		server.BaseUrl()+"/2/children?per_page=12345", nil,
+		server.BaseUrl()+"/2/children?per_page=255", nil,
 	)
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusOK, res.Code)
@@ -416,3 +418,58 @@ func Test_ListItemChildren_should_return_all_descendants(t *testing.T) {
 	actual := res.Body.String()
 	assert.JSONEq(t, expected, actual)
 }
+
+func Test_ListItemChildren_should_return_records_as_of_given_time(t *testing.T) {
+	_, engine, entClient, res := setupGinTest(t)
+	entClient.Item.Update().Where(item.IDIn(3, 4, 5)).SetParentID(2).
+		SaveX(context.Background())
+	entClient.Item.UpdateOneID(6).SetParentID(3).
+		ExecX(context.Background())
+	backdateHistory(t, entClient, time.Hour)
+	entClient.Item.UpdateOneID(4).SetParentID(1).
+		ExecX(context.Background())
+	asOf := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		schema.BaseUri+"/2/children?as_of="+url.QueryEscape(asOf), nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ListItemChildren200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, 3, actual.Total)
+	ids := make([]uint32, len(actual.Data))
+	for i, row := range actual.Data {
+		ids[i] = row.Id
+	}
+	assert.Equal(t, []uint32{3, 4, 5}, ids)
+}
+
+func Test_ListItemChildren_should_return_descendants_as_of_given_time(t *testing.T) {
+	_, engine, entClient, res := setupGinTest(t)
+	entClient.Item.Update().Where(item.IDIn(3, 4, 5)).SetParentID(2).
+		SaveX(context.Background())
+	entClient.Item.UpdateOneID(6).SetParentID(3).
+		ExecX(context.Background())
+	backdateHistory(t, entClient, time.Hour)
+	entClient.Item.UpdateOneID(4).SetParentID(1).
+		ExecX(context.Background())
+	entClient.Item.UpdateOneID(7).SetParentID(6).
+		ExecX(context.Background())
+	asOf := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		schema.BaseUri+"/2/children?recurse=1&as_of="+url.QueryEscape(asOf),
+		nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ListItemChildren200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, 4, actual.Total)
+	ids := make([]uint32, len(actual.Data))
+	for i, row := range actual.Data {
+		ids[i] = row.Id
+	}
+	assert.Equal(t, []uint32{3, 4, 5, 6}, ids)
+}
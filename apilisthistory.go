@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/eidng8/go-ent/softdelete"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+type ListItemHistoryPaginatedResponse struct {
+	*paginate.PaginatedList[ent.ItemHistory]
+}
+
+func (response ListItemHistoryPaginatedResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListItemHistory List change history of an Item
+// (GET /simple-tree/{id}/history)
+func (s Server) ListItemHistory(
+	ctx context.Context, request ListItemHistoryRequestObject,
+) (ListItemHistoryResponseObject, error) {
+	err := s.authorize(ctx, s.EC, request.Id, schema.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	gc := ctx.(*gin.Context)
+	query := s.EC.ItemHistory.Query().
+		Where(itemhistory.ItemID(request.Id)).
+		Order(itemhistory.ByRevision())
+	paginator := paginate.Paginator[ent.ItemHistory, ent.ItemHistoryQuery]{
+		BaseUrl:  s.BaseURL,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: ctx,
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		return nil, err
+	}
+	if 0 == page.Total {
+		// items created before history was introduced have no history
+		exists, err := s.EC.Item.Query().Where(item.ID(request.Id)).
+			Exist(softdelete.IncludeTrashed(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("item %d %w", request.Id, errNotFound)
+		}
+	}
+	return ListItemHistoryPaginatedResponse{PaginatedList: page}, nil
+}
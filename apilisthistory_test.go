@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_ListItemHistory_should_record_every_change(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	body := `{"name":"new name","parent_id":1}`
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/2", body,
+		withHeader(HeaderActor, "tester"), withHeader(HeaderRequestId, "req-1"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	req, _ := http.NewRequest(http.MethodDelete, schema.BaseUri+"/2", nil)
+	engine.ServeHTTP(httptest.NewRecorder(), req)
+	req, _ = http.NewRequest(http.MethodPost, schema.BaseUri+"/2/restore", nil)
+	engine.ServeHTTP(httptest.NewRecorder(), req)
+	rows := entClient.ItemHistory.Query().Where(itemhistory.ItemID(2)).
+		Order(itemhistory.ByRevision()).AllX(context.Background())
+	assert.Len(t, rows, 4)
+	actions := make([]itemhistory.Action, len(rows))
+	for i, row := range rows {
+		actions[i] = row.Action
+		assert.Equal(t, uint32(i+1), row.Revision)
+	}
+	assert.Equal(
+		t, []itemhistory.Action{
+			itemhistory.ActionCreate, itemhistory.ActionUpdate,
+			itemhistory.ActionDelete, itemhistory.ActionRestore,
+		}, actions,
+	)
+	update := rows[1]
+	assert.Equal(t, "name 1", *update.NameBefore)
+	assert.Equal(t, "new name", *update.NameAfter)
+	assert.Nil(t, update.ParentBefore)
+	assert.Equal(t, uint32(1), *update.ParentAfter)
+	assert.Equal(t, "tester", *update.Actor)
+	assert.Equal(t, "req-1", *update.RequestID)
+}
+
+func Test_ListItemHistory_should_return_1st_page(t *testing.T) {
+	server, engine, _, res := setupGinTest(t)
+	for _, name := range []string{"name a", "name b", "name c"} {
+		body := `{"name":"` + name + `"}`
+		serveRequest(engine, http.MethodPatch, schema.BaseUri+"/2", body)
+	}
+	req, _ := http.NewRequest(
+		http.MethodGet, schema.BaseUri+"/2/history?per_page=2", nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var page ListItemHistory200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Equal(t, 4, page.Total)
+	assert.Equal(t, 2, page.LastPage)
+	assert.Equal(t, server.BaseUrl()+"/2/history", page.Path)
+	assert.Len(t, page.Data, 2)
+	assert.Equal(t, Create, page.Data[0].Action)
+	assert.Equal(t, Update, page.Data[1].Action)
+	assert.Equal(t, "name a", *page.Data[1].NameAfter)
+}
+
+func Test_ListItemHistory_should_return_404_if_not_found(t *testing.T) {
+	_, engine, _, res := setupGinTest(t)
+	req, _ := http.NewRequest(
+		http.MethodGet, schema.BaseUri+"/987654321/history", nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
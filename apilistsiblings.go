@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+type ListItemSiblingsPaginatedResponse itemPageResponse
+
+func (response ListItemSiblingsPaginatedResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListItemSiblings List of sibling items
+// (GET /simple-tree/{id}/siblings)
+func (s Server) ListItemSiblings(
+	ctx context.Context, request ListItemSiblingsRequestObject,
+) (ListItemSiblingsResponseObject, error) {
+	err := s.authorize(ctx, s.EC, request.Id, schema.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	gc := ctx.(*gin.Context)
+	view := newItemView(request.Params.Fields, request.Params.Expand)
+	qc := trashedContext(ctx, request.Params.Trashed)
+	area, err := s.EC.Item.Get(qc, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	// siblings the principal cannot read are left out
+	query := s.EC.Item.Query().Where(s.readable(ctx, item.FieldID))
+	if nil == area.ParentID {
+		query.Where(item.ParentIDIsNil())
+	} else {
+		query.Where(item.ParentID(*area.ParentID))
+	}
+	if nil != request.Params.ExcludeSelf && *request.Params.ExcludeSelf {
+		query.Where(item.IDNEQ(area.ID))
+	}
+	applyTrashedFilter(request.Params.Trashed, query)
+	applyNameFilter(request.Params.Name, query)
+	s.eagerLoad(ctx, query, view)
+	page, err := getItemPage(
+		gc, qc, s.BaseURL, query, request.Params.Pagination,
+		request.Params.Cursor,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s.newListItemSiblingsResponse(qc, view, page)
+}
+
+// newListItemSiblingsResponse is the siblings counterpart of
+// newListItemResponse.
+func (s Server) newListItemSiblingsResponse(
+	qc context.Context, view itemView, page *cursorPage[ent.Item],
+) (ListItemSiblingsResponseObject, error) {
+	res, err := s.newItemPageResponse(qc, view, page)
+	if err != nil {
+		return nil, err
+	}
+	return ListItemSiblingsPaginatedResponse(res), nil
+}
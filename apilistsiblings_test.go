@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// siblingsTree makes items 2 to 6 children of item 1.
+var siblingsTree = map[uint32]uint32{2: 1, 3: 1, 4: 1, 5: 1, 6: 1}
+
+func Test_ListItemSiblings_lists_children_of_parent(t *testing.T) {
+	engine, _ := setupTree(t, siblingsTree)
+	page := getCursorPageOf(t, engine, schema.BaseUri+"/3/siblings")
+	assert.Equal(t, idRange(2, 6), pageIds(page))
+	assert.Equal(t, 5, page.Total)
+	page = getCursorPageOf(
+		t, engine, schema.BaseUri+"/3/siblings?exclude_self=1",
+	)
+	assert.Equal(t, []uint32{2, 4, 5, 6}, pageIds(page))
+}
+
+func Test_ListItemSiblings_lists_roots_of_root(t *testing.T) {
+	engine, _ := setupTree(t, siblingsTree)
+	page := getCursorPageOf(
+		t, engine, schema.BaseUri+"/10/siblings?per_page=5",
+	)
+	assert.Equal(t, []uint32{1, 7, 8, 9, 10}, pageIds(page))
+	assert.Equal(t, 45, page.Total)
+	page = getCursorPageOf(
+		t, engine, schema.BaseUri+"/10/siblings?per_page=20&name=name+1",
+	)
+	assert.Equal(t, idRange(11, 20), pageIds(page))
+}
+
+func Test_ListItemSiblings_paginates_by_cursor(t *testing.T) {
+	engine, _ := setupTree(t, siblingsTree)
+	base := schema.BaseUri + "/3/siblings?per_page=2&pagination=cursor"
+	page := getCursorPageOf(t, engine, base)
+	assert.Equal(t, []uint32{2, 3}, pageIds(page))
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, []uint32{4, 5}, pageIds(page))
+}
+
+func Test_ListItemSiblings_reads_trashed_items_by_mode(t *testing.T) {
+	engine, _ := setupTree(t, siblingsTree, 4)
+	page := getCursorPageOf(t, engine, schema.BaseUri+"/3/siblings")
+	assert.Equal(t, []uint32{2, 3, 5, 6}, pageIds(page))
+	page = getCursorPageOf(
+		t, engine, schema.BaseUri+"/3/siblings?trashed=only",
+	)
+	assert.Equal(t, []uint32{4}, pageIds(page))
+	assert.True(t, page.Data[0].DeletedAt.IsSpecified())
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/4/siblings", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	page = getCursorPageOf(
+		t, engine, schema.BaseUri+"/4/siblings?trashed=with",
+	)
+	assert.Equal(t, idRange(2, 6), pageIds(page))
+}
+
+func Test_ListItemSiblings_reports_404_if_not_found(t *testing.T) {
+	engine, _ := setupTree(t, siblingsTree)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1000/siblings", "",
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ListItemSiblings_leaves_out_unreadable_siblings(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	entClient.Grant.Create().SetItemID(3).SetSubject("eve").
+		SetPermission(grant.PermissionRead).SaveX(context.Background())
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/3/siblings", "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var page ListItem200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Equal(t, []uint32{3}, pageIds(page))
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2/siblings", "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -3,11 +3,10 @@ package main
 import (
 	"context"
 
-	"github.com/eidng8/go-ent/softdelete"
-	"github.com/oapi-codegen/nullable"
-
 	"github.com/eidng8/go-simple-tree/ent"
 	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
 // ReadItem Find a Item by ID
@@ -15,30 +14,45 @@ import (
 func (s Server) ReadItem(
 	ctx context.Context, request ReadItemRequestObject,
 ) (ReadItemResponseObject, error) {
-	qc := softdelete.NewSoftDeleteQueryContext(request.Params.Trashed, ctx)
-	area, err := s.EC.Item.Query().
-		Where(item.ID(request.Id)).Only(qc)
+	err := s.authorize(ctx, s.EC, request.Id, schema.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	view := newItemView(request.Params.Fields, request.Params.Expand)
+	err = view.checkAsOf(request.Params.AsOf)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ReadItem404JSONResponse{}, nil
-		}
 		return nil, err
 	}
-	return newReadItem200JSONResponseFromEnt(area), nil
+	if nil != request.Params.AsOf {
+		return s.readSnapshot(ctx, view, request)
+	}
+	qc := trashedContext(ctx, request.Params.Trashed)
+	query := s.EC.Item.Query().Where(item.ID(request.Id))
+	applyTrashedFilter(request.Params.Trashed, query)
+	s.eagerLoad(ctx, query, view)
+	area, err := query.Only(qc)
+	if err != nil {
+		return nil, err
+	}
+	var ancestors map[uint32][]*ent.Item
+	if view.ancestors {
+		ancestors, err = s.ancestorsOf(qc, []*ent.Item{area})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ReadItem200JSONResponse(view.itemList(area, ancestors)), nil
 }
 
-func newReadItem200JSONResponseFromEnt(eaa *ent.Item) ReadItem200JSONResponse {
-	aa := ReadItem200JSONResponse{}
-	aa.Id = eaa.ID
-	aa.Name = eaa.Name
-	if eaa.ParentID != nil {
-		val := *eaa.ParentID
-		aa.ParentId = &val
-	}
-	if eaa.DeletedAt != nil {
-		aa.DeletedAt = nullable.NewNullableWithValue(*eaa.DeletedAt)
+func (s Server) readSnapshot(
+	ctx context.Context, view itemView, request ReadItemRequestObject,
+) (ReadItemResponseObject, error) {
+	row, err := s.snapshotQuery(*request.Params.AsOf, request.Params.Trashed).
+		Where(itemhistory.ItemID(request.Id)).Only(ctx)
+	if err != nil {
+		return nil, err
 	}
-	aa.CreatedAt = eaa.CreatedAt
-	aa.UpdatedAt = eaa.UpdatedAt
-	return aa
+	return ReadItem200JSONResponse(
+		view.itemList(newItemFromHistory(row), nil),
+	), nil
 }
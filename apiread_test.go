@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-ent/softdelete"
 	"github.com/oapi-codegen/nullable"
@@ -71,3 +73,36 @@ func Test_ReadItem_should_return_404_if_not_found(t *testing.T) {
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusNotFound, res.Code)
 }
+
+func Test_ReadItem_should_return_record_as_of_given_time(t *testing.T) {
+	_, engine, entClient, res := setupGinTest(t)
+	backdateHistory(t, entClient, time.Hour)
+	entClient.Item.UpdateOneID(2).SetName("new name").SetParentID(1).
+		ExecX(context.Background())
+	asOf := url.QueryEscape(
+		time.Now().Add(-time.Minute).Format(time.RFC3339),
+	)
+	req, _ := http.NewRequest(
+		http.MethodGet, schema.BaseUri+"/2?as_of="+asOf, nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ReadItem200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, uint32(2), actual.Id)
+	assert.Equal(t, "name 1", actual.Name)
+	assert.Nil(t, actual.ParentId)
+}
+
+func Test_ReadItem_should_return_404_if_not_exist_at_given_time(t *testing.T) {
+	_, engine, entClient, res := setupGinTest(t)
+	backdateHistory(t, entClient, time.Hour)
+	asOf := url.QueryEscape(
+		time.Now().Add(-2 * time.Hour).Format(time.RFC3339),
+	)
+	req, _ := http.NewRequest(
+		http.MethodGet, schema.BaseUri+"/2?as_of="+asOf, nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/oapi-codegen/nullable"
 
 	"github.com/eidng8/go-simple-tree/ent"
 	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
 // ReadItemParent Find a Item by ID
@@ -12,16 +16,32 @@ import (
 func (s Server) ReadItemParent(
 	ctx context.Context, request ReadItemParentRequestObject,
 ) (ReadItemParentResponseObject, error) {
+	err := s.authorize(ctx, s.EC, request.Id, schema.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	// the trashed mode applies to the parent, while the item is looked up
+	// among trashed ones too unless they are left out
+	qc := trashedContext(ctx, request.Params.Trashed)
 	area, err := s.EC.Item.Query().Where(item.ID(uint32(request.Id))).
-		WithParent().Only(ctx)
+		WithParent(
+			func(q *ent.ItemQuery) {
+				applyTrashedFilter(request.Params.Trashed, q)
+			},
+		).Only(qc)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ReadItemParent404JSONResponse{}, nil
-		}
 		return nil, err
 	}
-	if nil == area || nil == area.Edges.Parent {
-		return ReadItemParent404JSONResponse{}, nil
+	if nil == area.ParentID {
+		return nil, fmt.Errorf("item %d has no parent: %w", area.ID, errNotFound)
+	}
+	if nil == area.Edges.Parent {
+		return nil, fmt.Errorf("parent of item %d: %w", area.ID, errNotFound)
+	}
+	// grants on the item don't extend to its parent
+	err = s.authorize(ctx, s.EC, area.Edges.Parent.ID, schema.PermissionRead)
+	if err != nil {
+		return nil, err
 	}
 	return newReadItemParent200JSONResponseFromEnt(area.Edges.Parent), nil
 }
@@ -36,6 +56,9 @@ func newReadItemParent200JSONResponseFromEnt(
 		val := *eaa.ParentID
 		aar.ParentId = &val
 	}
+	if eaa.DeletedAt != nil {
+		aar.DeletedAt = nullable.NewNullableWithValue(*eaa.DeletedAt)
+	}
 	aar.CreatedAt = eaa.CreatedAt
 	aar.UpdatedAt = eaa.UpdatedAt
 	return aar
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// ReadItemRelation Find how two Items relate
+// (GET /simple-tree/{id}/relation/{other})
+func (s Server) ReadItemRelation(
+	ctx context.Context, request ReadItemRelationRequestObject,
+) (ReadItemRelationResponseObject, error) {
+	rows := make([]*ent.Item, 2)
+	for i, id := range []uint32{request.Id, request.Other} {
+		err := s.authorize(ctx, s.EC, id, schema.PermissionRead)
+		if err != nil {
+			return nil, err
+		}
+		rows[i], err = s.EC.Item.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	chains, err := s.ancestorChains(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	// lineages run from the root down to each item
+	from := slices.Concat(chains[rows[0].ID], rows[:1])
+	to := slices.Concat(chains[rows[1].ID], rows[1:])
+	n := 0
+	for n < len(from) && n < len(to) && from[n].ID == to[n].ID {
+		n++
+	}
+	rel := ReadItemRelation200JSONResponse{
+		Id:    request.Id,
+		Other: request.Other,
+		Path:  []ItemList{},
+	}
+	if 0 == n {
+		return rel, nil
+	}
+	lca := from[n-1]
+	// up from the item to the lowest common ancestor, then down to the other
+	path := slices.Clone(from[n-1:])
+	slices.Reverse(path)
+	path = append(path, to[n:]...)
+	path, err = s.filterReadable(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	// items related only through an unreadable ancestor are told apart from
+	// unrelated ones by nothing, and the distance counts readable items only
+	if !slices.ContainsFunc(
+		path, func(row *ent.Item) bool { return row.ID == lca.ID },
+	) {
+		return rel, nil
+	}
+	distance := len(path) - 1
+	rel.Related = true
+	rel.Ancestor = lca.ID == request.Id && request.Id != request.Other
+	rel.Descendant = lca.ID == request.Other && request.Id != request.Other
+	rel.Distance = &distance
+	for _, row := range path {
+		aa := newItemListFromEnt(row)
+		rel.Path = append(rel.Path, aa)
+		if row.ID == lca.ID {
+			rel.Lca = &aa
+		}
+	}
+	return rel, nil
+}
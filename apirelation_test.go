@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func getRelation(
+	t *testing.T, engine http.Handler, id, other uint32, opts ...requestOption,
+) ItemRelation {
+	res := serveRequest(
+		engine, http.MethodGet,
+		fmt.Sprintf("%s/%d/relation/%d", schema.BaseUri, id, other), "",
+		opts...,
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	var rel ItemRelation
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &rel))
+	return rel
+}
+
+func relationPath(rel ItemRelation) []uint32 {
+	ids := make([]uint32, len(rel.Path))
+	for i, row := range rel.Path {
+		ids[i] = row.Id
+	}
+	return ids
+}
+
+// relationTree makes items 2 & 3 children of item 1, item 4 child of item 2,
+// item 5 child of item 4, and item 6 child of item 3.
+var relationTree = map[uint32]uint32{2: 1, 3: 1, 4: 2, 5: 4, 6: 3}
+
+func Test_ReadItemRelation_finds_path_through_lowest_common_ancestor(t *testing.T) {
+	engine, _ := setupTree(t, relationTree)
+	rel := getRelation(t, engine, 5, 6)
+	assert.True(t, rel.Related)
+	assert.False(t, rel.Ancestor)
+	assert.False(t, rel.Descendant)
+	assert.Equal(t, uint32(1), rel.Lca.Id)
+	assert.Equal(t, 5, *rel.Distance)
+	assert.Equal(t, []uint32{5, 4, 2, 1, 3, 6}, relationPath(rel))
+}
+
+func Test_ReadItemRelation_tells_ancestors_and_descendants(t *testing.T) {
+	engine, _ := setupTree(t, relationTree)
+	rel := getRelation(t, engine, 2, 5)
+	assert.True(t, rel.Ancestor)
+	assert.False(t, rel.Descendant)
+	assert.Equal(t, uint32(2), rel.Lca.Id)
+	assert.Equal(t, 2, *rel.Distance)
+	assert.Equal(t, []uint32{2, 4, 5}, relationPath(rel))
+	rel = getRelation(t, engine, 5, 2)
+	assert.False(t, rel.Ancestor)
+	assert.True(t, rel.Descendant)
+	assert.Equal(t, []uint32{5, 4, 2}, relationPath(rel))
+	rel = getRelation(t, engine, 4, 4)
+	assert.False(t, rel.Ancestor)
+	assert.False(t, rel.Descendant)
+	assert.Equal(t, 0, *rel.Distance)
+	assert.Equal(t, []uint32{4}, relationPath(rel))
+}
+
+func Test_ReadItemRelation_of_unrelated_items(t *testing.T) {
+	engine, _ := setupTree(t, relationTree)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/5/relation/10", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(
+		t,
+		`{"id":5,"other":10,"related":false,"ancestor":false,"descendant":false,"path":[]}`,
+		res.Body.String(),
+	)
+}
+
+func Test_ReadItemRelation_walks_through_trashed_items(t *testing.T) {
+	engine, _ := setupTree(t, map[uint32]uint32{2: 1, 3: 2}, 2)
+	rel := getRelation(t, engine, 3, 1)
+	assert.True(t, rel.Descendant)
+	assert.Equal(t, []uint32{3, 2, 1}, relationPath(rel))
+	assert.True(t, rel.Path[1].DeletedAt.IsSpecified())
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2/relation/1", "",
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ReadItemRelation_reports_404_if_not_found(t *testing.T) {
+	engine, _ := setupTree(t, relationTree)
+	for _, uri := range []string{"/1000/relation/1", "/1/relation/1000"} {
+		res := serveRequest(engine, http.MethodGet, schema.BaseUri+uri, "")
+		assert.Equal(t, http.StatusNotFound, res.Code, uri)
+	}
+}
+
+func Test_ReadItemRelation_leaves_out_unreadable_items(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	ctx := context.Background()
+	for _, id := range []uint32{3, 4} {
+		entClient.Grant.Create().SetItemID(id).SetSubject("eve").
+			SetPermission(grant.PermissionRead).SaveX(ctx)
+	}
+	rel := getRelation(t, engine, 3, 4, withHeader(HeaderApiKey, "eve-key"))
+	assert.False(t, rel.Related)
+	assert.Nil(t, rel.Lca)
+	assert.Nil(t, rel.Distance)
+	assert.Empty(t, rel.Path)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1/relation/4", "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
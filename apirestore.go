@@ -6,6 +6,7 @@ import (
 	"github.com/eidng8/go-ent/softdelete"
 
 	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
 func (s Server) RestoreItem(
@@ -22,16 +23,23 @@ func (s Server) RestoreItem(
 			_ = tx.Rollback()
 		}
 	}()
-	err = tx.Item.UpdateOneID(id).ClearDeletedAt().Exec(qc)
+	err = s.authorize(qc, tx.Client(), id, schema.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	var aa *ent.Item
+	aa, err = tx.Item.UpdateOneID(id).ClearDeletedAt().Save(qc)
+	if err != nil {
+		return nil, err
+	}
+	err = addEvent(qc, tx, EventRestore, newItemEventData(aa))
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return RestoreItem404JSONResponse{}, nil
-		}
 		return nil, err
 	}
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+	s.Outbox.Notify()
 	return RestoreItem204Response{}, nil
 }
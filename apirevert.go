@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// RevertItem Reverts a Item to a previous revision
+// (POST /simple-tree/{id}/revert)
+func (s Server) RevertItem(
+	ctx context.Context, request RevertItemRequestObject,
+) (RevertItemResponseObject, error) {
+	id := request.Id
+	tx, err := s.EC.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if nil != err {
+			_ = tx.Rollback()
+		}
+	}()
+	err = s.authorize(ctx, tx.Client(), id, schema.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	// trashed items have to be restored before being reverted
+	var old *ent.Item
+	old, err = tx.Item.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var rev *ent.ItemHistory
+	rev, err = tx.ItemHistory.Query().
+		Where(
+			itemhistory.ItemID(id),
+			itemhistory.Revision(request.Body.Revision),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = ent.NewValidationError(
+				"revision", fmt.Errorf("revision %d of item %d not found",
+					request.Body.Revision, id),
+			)
+		}
+		return nil, err
+	}
+	if !equalParent(old.ParentID, rev.ParentAfter) {
+		err = s.authorizeParent(ctx, tx.Client(), rev.ParentAfter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	uo := tx.Item.UpdateOneID(id)
+	if nil != rev.NameAfter {
+		uo.SetName(*rev.NameAfter)
+	}
+	if nil == rev.ParentAfter {
+		uo.ClearParentID()
+	} else {
+		err = checkParent(ctx, tx.Client(), id, *rev.ParentAfter)
+		if err != nil {
+			return nil, err
+		}
+		uo.SetParentID(*rev.ParentAfter)
+	}
+	var aa *ent.Item
+	aa, err = uo.Save(newRevertContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	err = addUpdateEvent(ctx, tx, old, aa)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	s.Outbox.Notify()
+	return RevertItem200JSONResponse{
+		Id:        aa.ID,
+		ParentId:  aa.ParentID,
+		Name:      aa.Name,
+		CreatedAt: aa.CreatedAt,
+		UpdatedAt: aa.UpdatedAt,
+	}, nil
+}
+
+// checkParent returns a validation error if the given parent doesn't exist,
+// or if setting it as the parent of the given item would create a cycle.
+func checkParent(
+	ctx context.Context, client *ent.Client, id, parentId uint32,
+) error {
+	if parentId == id {
+		return ent.NewValidationError(
+			"parent_id", fmt.Errorf("ParentId cannot be equal to self"),
+		)
+	}
+	exists, err := client.Item.Query().Where(item.ID(parentId)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ent.NewValidationError(
+			"parent_id", fmt.Errorf("parent %d not found", parentId),
+		)
+	}
+	// trashed items are still part of the tree, so walk through them too
+	qc := softdelete.IncludeTrashed(ctx)
+	visited := map[uint32]bool{}
+	pid := &parentId
+	for nil != pid && !visited[*pid] {
+		if *pid == id {
+			return ent.NewValidationError(
+				"parent_id", cycleError{id: id, parentId: parentId},
+			)
+		}
+		visited[*pid] = true
+		row, err := client.Item.Get(qc, *pid)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		pid = row.ParentID
+	}
+	return nil
+}
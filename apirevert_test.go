@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_RevertItem_reverts_to_given_revision(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.UpdateOneID(2).SetName("new name").SetParentID(1).
+		ExecX(context.Background())
+	body := `{"revision":1}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/2/revert", body,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	aa := entClient.Item.GetX(context.Background(), 2)
+	assert.Equal(t, "name 1", aa.Name)
+	assert.Nil(t, aa.ParentID)
+	b, err := json.Marshal(
+		RevertItem200JSONResponse{
+			Id:        2,
+			Name:      "name 1",
+			CreatedAt: aa.CreatedAt,
+			UpdatedAt: aa.UpdatedAt,
+		},
+	)
+	assert.Nil(t, err)
+	assert.JSONEq(t, string(b), res.Body.String())
+	rev := entClient.ItemHistory.Query().
+		Where(itemhistory.ItemID(2), itemhistory.Revision(3)).
+		OnlyX(context.Background())
+	assert.Equal(t, itemhistory.ActionRevert, rev.Action)
+	assert.Equal(t, "new name", *rev.NameBefore)
+	assert.Equal(t, uint32(1), *rev.ParentBefore)
+	assert.Equal(t, "name 1", *rev.NameAfter)
+	assert.Nil(t, rev.ParentAfter)
+}
+
+func Test_RevertItem_reports_404_if_item_deleted(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.UpdateOneID(2).SetDeletedAt(time.Now()).
+		ExecX(context.Background())
+	body := `{"revision":1}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/2/revert", body,
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_RevertItem_reports_422_if_revision_not_exist(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	body := `{"revision":2}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/2/revert", body,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_RevertItem_reports_422_if_parent_not_exist(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.UpdateOneID(2).SetParentID(1).
+		ExecX(context.Background())
+	entClient.Item.UpdateOneID(2).ClearParentID().
+		ExecX(context.Background())
+	entClient.Item.UpdateOneID(1).SetDeletedAt(time.Now()).
+		ExecX(context.Background())
+	body := `{"revision":2}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/2/revert", body,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	aa := entClient.Item.GetX(context.Background(), 2)
+	assert.Nil(t, aa.ParentID)
+}
+
+func Test_RevertItem_reports_422_if_it_creates_cycle(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.UpdateOneID(2).SetParentID(3).
+		ExecX(context.Background())
+	entClient.Item.UpdateOneID(2).ClearParentID().
+		ExecX(context.Background())
+	entClient.Item.UpdateOneID(3).SetParentID(4).
+		ExecX(context.Background())
+	entClient.Item.UpdateOneID(4).SetParentID(2).
+		ExecX(context.Background())
+	body := `{"revision":2}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/2/revert", body,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	aa := entClient.Item.GetX(context.Background(), 2)
+	assert.Nil(t, aa.ParentID)
+	assert.Equal(
+		t, 3, entClient.ItemHistory.Query().
+			Where(itemhistory.ItemID(2)).CountX(context.Background()),
+	)
+}
+
+func Test_RevertItem_declares_422_in_spec(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.Nil(t, err)
+	op := swagger.Paths.Value("/{id}/revert").Post
+	assert.NotNil(t, op.Responses.Value("422"))
+}
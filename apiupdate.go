@@ -2,9 +2,9 @@ package main
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
 // UpdateItem Updates a Item
@@ -21,15 +21,31 @@ func (s Server) UpdateItem(
 			_ = tx.Rollback()
 		}
 	}()
+	err = s.authorize(ctx, tx.Client(), request.Id, schema.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	var old *ent.Item
+	old, err = tx.Item.Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
 	ac := tx.Item.UpdateOneID(request.Id)
 	if request.Body.Name != nil {
 		ac.SetName(*request.Body.Name)
 	}
 	if request.Body.ParentId != nil {
-		if *request.Body.ParentId == request.Id {
-			return nil, ent.NewValidationError(
-				"parent_id", fmt.Errorf("ParentId cannot be equal to self"),
+		if !equalParent(old.ParentID, request.Body.ParentId) {
+			err = s.authorizeParent(ctx, tx.Client(), request.Body.ParentId)
+			if err != nil {
+				return nil, err
+			}
+			err = checkParent(
+				ctx, tx.Client(), request.Id, *request.Body.ParentId,
 			)
+			if err != nil {
+				return nil, err
+			}
 		}
 		ac.SetParentID(*request.Body.ParentId)
 	}
@@ -38,10 +54,15 @@ func (s Server) UpdateItem(
 	if err != nil {
 		return nil, err
 	}
+	err = addUpdateEvent(ctx, tx, old, aa)
+	if err != nil {
+		return nil, err
+	}
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+	s.Outbox.Notify()
 	return UpdateItem200JSONResponse{
 		Id:        aa.ID,
 		ParentId:  aa.ParentID,
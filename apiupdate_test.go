@@ -16,7 +16,7 @@ import (
 
 func Test_UpdateItem_updates_existing_record(t *testing.T) {
 	_, engine, entClient, res := setupGinTest(t)
-	body := `{"name":"test name","abbr":"test abbr","parent_id":1}`
+	body := `{"name":"test name","parent_id":1}`
 	req, _ := http.NewRequest(
 		http.MethodPatch, schema.BaseUri+"/2",
 		io.NopCloser(strings.NewReader(body)),
@@ -44,7 +44,7 @@ func Test_UpdateItem_updates_existing_record(t *testing.T) {
 func Test_UpdateItem_reports_404_if_update_deleted_record(t *testing.T) {
 	_, engine, entClient, res := setupGinTest(t)
 	entClient.Item.UpdateOneID(2).SetDeletedAt(time.Now()).ExecX(context.Background())
-	body := `{"name":"test name","abbr":"test abbr","parent_id":1}`
+	body := `{"name":"test name","parent_id":1}`
 	req, _ := http.NewRequest(
 		http.MethodPatch, schema.BaseUri+"/2",
 		io.NopCloser(strings.NewReader(body)),
@@ -53,15 +53,20 @@ func Test_UpdateItem_reports_404_if_update_deleted_record(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, res.Code)
 }
 
-func Test_UpdateItem_reports_422_if_request_body_invalid(t *testing.T) {
+func Test_UpdateItem_reports_400_if_request_body_invalid(t *testing.T) {
 	_, engine, _, res := setupGinTest(t)
-	body := `{"name":"a","parent_id":1}`
+	body := `{"name":"a","parent_id":1,"abbr":"a"}`
 	req, _ := http.NewRequest(
 		http.MethodPatch, schema.BaseUri+"/2",
 		io.NopCloser(strings.NewReader(body)),
 	)
 	engine.ServeHTTP(res, req)
-	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	var actual ApiError
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Len(t, actual.Errors, 2)
+	assert.Contains(t, res.Body.String(), `"field":"name"`)
+	assert.Contains(t, res.Body.String(), `property \"abbr\" is unsupported`)
 }
 
 func Test_UpdateItem_reports_422_if_parentId_equals_self(t *testing.T) {
@@ -74,3 +79,14 @@ func Test_UpdateItem_reports_422_if_parentId_equals_self(t *testing.T) {
 	engine.ServeHTTP(res, req)
 	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
 }
+
+func Test_UpdateItem_reports_422_if_it_creates_cycle(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.UpdateOneID(3).SetParentID(2).ExecX(context.Background())
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/2", `{"parent_id":3}`,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	assert.Contains(t, res.Body.String(), "item 3 is a descendant of item 2")
+	assert.Nil(t, entClient.Item.GetX(context.Background(), 2).ParentID)
+}
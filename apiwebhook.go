@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+	"github.com/eidng8/go-simple-tree/ent/webhook"
+	"github.com/eidng8/go-simple-tree/ent/webhookdelivery"
+)
+
+type CreateWebhook201JSONResponse WebhookCreate
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookPaginatedResponse struct {
+	*paginate.PaginatedList[ent.Webhook]
+}
+
+func (response ListWebhookPaginatedResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetterPaginatedResponse struct {
+	*paginate.PaginatedList[ent.WebhookDelivery]
+}
+
+func (response ListWebhookDeadLetterPaginatedResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListWebhook List Webhooks
+// (GET /simple-tree/webhooks)
+func (s Server) ListWebhook(
+	ctx context.Context, _ ListWebhookRequestObject,
+) (ListWebhookResponseObject, error) {
+	if err := s.authorizeSuperuser(ctx); err != nil {
+		return nil, err
+	}
+	paginator := paginate.Paginator[ent.Webhook, ent.WebhookQuery]{
+		BaseUrl:  s.BaseURL,
+		Query:    s.EC.Webhook.Query().Order(webhook.ByID()),
+		GinCtx:   ctx.(*gin.Context),
+		QueryCtx: ctx,
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		return nil, err
+	}
+	return ListWebhookPaginatedResponse{PaginatedList: page}, nil
+}
+
+// CreateWebhook Create a new Webhook
+// (POST /simple-tree/webhooks)
+func (s Server) CreateWebhook(
+	ctx context.Context, request CreateWebhookRequestObject,
+) (CreateWebhookResponseObject, error) {
+	if err := s.authorizeSuperuser(ctx); err != nil {
+		return nil, err
+	}
+	wc := s.EC.Webhook.Create().
+		SetURL(request.Body.Url).
+		SetSecret(request.Body.Secret).
+		SetNillableActive(request.Body.Active)
+	if nil != request.Body.Events {
+		if err := validateWebhookEvents(*request.Body.Events); err != nil {
+			return nil, err
+		}
+		wc.SetEvents(*request.Body.Events)
+	}
+	wh, err := wc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return CreateWebhook201JSONResponse(newWebhookCreate(wh)), nil
+}
+
+// DeleteWebhook Deletes a Webhook by ID
+// (DELETE /simple-tree/webhooks/{id})
+func (s Server) DeleteWebhook(
+	ctx context.Context, request DeleteWebhookRequestObject,
+) (DeleteWebhookResponseObject, error) {
+	if err := s.authorizeSuperuser(ctx); err != nil {
+		return nil, err
+	}
+	tx, err := s.EC.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if nil != err {
+			_ = tx.Rollback()
+		}
+	}()
+	_, err = tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.WebhookID(request.Id)).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Webhook.DeleteOneID(request.Id).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return DeleteWebhook204Response{}, nil
+}
+
+// ReadWebhook Find a Webhook by ID
+// (GET /simple-tree/webhooks/{id})
+func (s Server) ReadWebhook(
+	ctx context.Context, request ReadWebhookRequestObject,
+) (ReadWebhookResponseObject, error) {
+	if err := s.authorizeSuperuser(ctx); err != nil {
+		return nil, err
+	}
+	wh, err := s.EC.Webhook.Get(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return ReadWebhook200JSONResponse(newWebhookCreate(wh)), nil
+}
+
+// UpdateWebhook Updates a Webhook
+// (PATCH /simple-tree/webhooks/{id})
+func (s Server) UpdateWebhook(
+	ctx context.Context, request UpdateWebhookRequestObject,
+) (UpdateWebhookResponseObject, error) {
+	if err := s.authorizeSuperuser(ctx); err != nil {
+		return nil, err
+	}
+	wu := s.EC.Webhook.UpdateOneID(request.Id).
+		SetNillableURL(request.Body.Url).
+		SetNillableSecret(request.Body.Secret).
+		SetNillableActive(request.Body.Active)
+	if nil != request.Body.Events {
+		if err := validateWebhookEvents(*request.Body.Events); err != nil {
+			return nil, err
+		}
+		if 0 == len(*request.Body.Events) {
+			wu.ClearEvents()
+		} else {
+			wu.SetEvents(*request.Body.Events)
+		}
+	}
+	wh, err := wu.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return UpdateWebhook200JSONResponse(newWebhookCreate(wh)), nil
+}
+
+// ListWebhookDeadLetter List dead letters of a Webhook
+// (GET /simple-tree/webhooks/{id}/dead-letters)
+func (s Server) ListWebhookDeadLetter(
+	ctx context.Context, request ListWebhookDeadLetterRequestObject,
+) (ListWebhookDeadLetterResponseObject, error) {
+	if err := s.authorizeSuperuser(ctx); err != nil {
+		return nil, err
+	}
+	query := s.EC.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.WebhookID(request.Id),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusDead),
+		).
+		Order(webhookdelivery.ByID())
+	paginator := paginate.Paginator[ent.WebhookDelivery, ent.WebhookDeliveryQuery]{
+		BaseUrl:  s.BaseURL,
+		Query:    query,
+		GinCtx:   ctx.(*gin.Context),
+		QueryCtx: ctx,
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		return nil, err
+	}
+	if 0 == page.Total {
+		exists, err := s.EC.Webhook.Query().Where(webhook.ID(request.Id)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("webhook %d %w", request.Id, errNotFound)
+		}
+	}
+	return ListWebhookDeadLetterPaginatedResponse{PaginatedList: page}, nil
+}
+
+func validateWebhookEvents(events []string) error {
+	for _, event := range events {
+		if !slices.Contains(schema.WebhookEvents, event) {
+			return ent.NewValidationError(
+				"events", fmt.Errorf("unknown event %q", event),
+			)
+		}
+	}
+	return nil
+}
+
+// newWebhookCreate returns the response of the given webhook, which is the
+// same for create, read and update.
+func newWebhookCreate(wh *ent.Webhook) WebhookCreate {
+	res := WebhookCreate{
+		Id:        wh.ID,
+		Url:       wh.URL,
+		Active:    wh.Active,
+		CreatedAt: wh.CreatedAt,
+		UpdatedAt: wh.UpdatedAt,
+	}
+	if len(wh.Events) > 0 {
+		events := slices.Clone(wh.Events)
+		res.Events = &events
+	}
+	return res
+}
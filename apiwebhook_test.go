@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+	"github.com/eidng8/go-simple-tree/ent/webhookdelivery"
+)
+
+func Test_CreateWebhook_creates_webhook(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	body := `{"url":"http://localhost/hook","secret":"0123456789abcdef","events":["move"]}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/webhooks", body,
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	assert.NotContains(t, res.Body.String(), "secret")
+	var actual CreateWebhook201JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, "http://localhost/hook", actual.Url)
+	assert.True(t, actual.Active)
+	assert.Equal(t, []string{EventMove}, *actual.Events)
+	wh := entClient.Webhook.GetX(context.Background(), actual.Id)
+	assert.Equal(t, "0123456789abcdef", wh.Secret)
+}
+
+func Test_CreateWebhook_reports_422_if_event_unknown(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	body := `{"url":"http://localhost/hook","secret":"0123456789abcdef","events":["rename"]}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/webhooks", body,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_CreateWebhook_reports_422_if_url_invalid(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	body := `{"url":"ftp://localhost/hook","secret":"0123456789abcdef"}`
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/webhooks", body,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_UpdateWebhook_updates_webhook(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	wh := createTestWebhook(t, entClient, "http://localhost/hook", EventMove)
+	body := `{"active":false,"events":[]}`
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/webhooks/1", body,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual UpdateWebhook200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.False(t, actual.Active)
+	assert.Nil(t, actual.Events)
+	wh = entClient.Webhook.GetX(context.Background(), wh.ID)
+	assert.False(t, wh.Active)
+	assert.Empty(t, wh.Events)
+}
+
+func Test_ReadWebhook_reports_404_if_not_found(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/webhooks/1", "",
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ListWebhook_returns_1st_page(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	for range 3 {
+		createTestWebhook(t, entClient, "http://localhost/hook")
+	}
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/webhooks?per_page=2", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.NotContains(t, res.Body.String(), "secret")
+	var actual ListWebhook200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, 3, actual.Total)
+	assert.Len(t, actual.Data, 2)
+	assert.True(t, actual.Data[0].Active)
+}
+
+func Test_DeleteWebhook_deletes_webhook_and_deliveries(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	wh := createTestWebhook(t, entClient, "http://localhost/hook")
+	entClient.WebhookDelivery.Create().SetWebhookID(wh.ID).SetEventID(1).
+		SetEvent(EventCreate).SetPayload("{}").
+		SetStatus(webhookdelivery.StatusDead).ExecX(context.Background())
+	res := serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/webhooks/1", "",
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	assert.Equal(
+		t, 0, entClient.WebhookDelivery.Query().CountX(context.Background()),
+	)
+	res = serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/webhooks/1", "",
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ListWebhookDeadLetter_excludes_pending_deliveries(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	wh := createTestWebhook(t, entClient, "http://localhost/hook")
+	entClient.WebhookDelivery.Create().SetWebhookID(wh.ID).SetEventID(1).
+		SetEvent(EventCreate).SetPayload("{}").
+		SetNextAttemptAt(time.Now().Add(time.Hour)).
+		ExecX(context.Background())
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/webhooks/1/dead-letters", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ListWebhookDeadLetter200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, 0, actual.Total)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/webhooks/2/dead-letters", "",
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// HeaderApiKey is the request header carrying a static API key.
+const HeaderApiKey = "X-API-Key"
+
+// minHmacKeySize is the minimum size of HS256 keys in bytes.
+const minHmacKeySize = 32
+
+// jwtLeeway is the clock skew tolerated when validating JWT time claims.
+const jwtLeeway = 30 * time.Second
+
+// errNoCredentials is returned by authenticators if the request doesn't carry
+// credentials of their kind.
+var errNoCredentials = errors.New("no credentials")
+
+// Principal is the authenticated identity making a request.
+type Principal struct {
+	Subject string
+	// Method is how the principal was authenticated, `jwt` or `api_key`.
+	Method string
+}
+
+type principalKey struct{}
+
+// NewPrincipalContext returns a new context carrying the principal.
+func NewPrincipalContext(parent context.Context, p *Principal) context.Context {
+	return context.WithValue(parent, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal of the request, or nil if the
+// request is not authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// Authenticator authenticates requests. It returns errNoCredentials if the
+// request carries no credentials it handles, to let the next one try.
+type Authenticator interface {
+	Authenticate(req *http.Request) (*Principal, error)
+}
+
+// authMiddleware rejects requests not authenticated by any of the given
+// authenticators, and attaches the principal to the context of the others.
+func authMiddleware(authenticators ...Authenticator) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		err := errNoCredentials
+		for _, auth := range authenticators {
+			var p *Principal
+			p, err = auth.Authenticate(gc.Request)
+			if nil == err {
+				gc.Request = gc.Request.WithContext(
+					NewPrincipalContext(gc.Request.Context(), p),
+				)
+				gc.Next()
+				return
+			}
+			if !errors.Is(err, errNoCredentials) {
+				break
+			}
+		}
+		_ = gc.Error(err)
+		gc.Header("WWW-Authenticate", `Bearer realm="simple-tree"`)
+		abortWithError(
+			gc, newApiError(http.StatusUnauthorized, err, err.Error()),
+		)
+	}
+}
+
+// JWTAuthenticator validates HS256 and RS256 signed bearer tokens. The `sub`
+// claim of the token is used as the subject of the principal.
+type JWTAuthenticator struct {
+	// keys maps key IDs to keys, which are either `[]byte` for HS256, or
+	// `*rsa.PublicKey` for RS256. A key with empty ID accepts any token.
+	keys   map[string]interface{}
+	parser *jwt.Parser
+}
+
+// NewJWTAuthenticator returns an authenticator validating tokens with the
+// given keys. The issuer and audience are not checked if empty.
+func NewJWTAuthenticator(
+	keys map[string]interface{}, issuer, audience string,
+) *JWTAuthenticator {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"HS256", "RS256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if "" != issuer {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if "" != audience {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+	return &JWTAuthenticator{keys: keys, parser: jwt.NewParser(opts...)}
+}
+
+func (a *JWTAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	scheme, token, found := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold("Bearer", scheme) {
+		return nil, errNoCredentials
+	}
+	var claims jwt.RegisteredClaims
+	_, err := a.parser.ParseWithClaims(
+		strings.TrimSpace(token), &claims, a.keyFunc,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if "" == claims.Subject {
+		return nil, errors.New("token has no subject")
+	}
+	return &Principal{Subject: claims.Subject, Method: "jwt"}, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := a.keys[kid]
+	if !ok {
+		key, ok = a.keys[""]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	// the key decides the algorithm, so HS256 tokens can't be signed with
+	// the public RSA key
+	switch key.(type) {
+	case []byte:
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.New("key requires HS256")
+		}
+	case *rsa.PublicKey:
+		if token.Method != jwt.SigningMethodRS256 {
+			return nil, errors.New("key requires RS256")
+		}
+	}
+	return key, nil
+}
+
+// loadJWTKeyFile loads a PEM encoded RSA public key or certificate for RS256,
+// or a raw secret for HS256.
+func loadJWTKeyFile(path string) (interface{}, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(b); nil != block {
+		return jwt.ParseRSAPublicKeyFromPEM(b)
+	}
+	secret := bytes.TrimSpace(b)
+	if len(secret) < minHmacKeySize {
+		return nil, fmt.Errorf(
+			"HS256 key must be at least %d bytes", minHmacKeySize,
+		)
+	}
+	return secret, nil
+}
+
+// loadJWKSFile loads RSA and symmetric signing keys from a JSON Web Key Set
+// file. Keys of other types are ignored.
+func loadJWKSFile(path string) (map[string]interface{}, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			K   string `json:"k"`
+		} `json:"keys"`
+	}
+	if err = json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if "" != jwk.Use && "sig" != jwk.Use {
+			continue
+		}
+		switch jwk.Kty {
+		case "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", jwk.Kid, err)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", jwk.Kid, err)
+			}
+			exp := new(big.Int).SetBytes(e)
+			if !exp.IsInt64() || exp.Int64() < 3 {
+				return nil, fmt.Errorf("key %q: invalid exponent", jwk.Kid)
+			}
+			keys[jwk.Kid] = &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n), E: int(exp.Int64()),
+			}
+		case "oct":
+			k, err := base64.RawURLEncoding.DecodeString(jwk.K)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: %w", jwk.Kid, err)
+			}
+			if len(k) < minHmacKeySize {
+				return nil, fmt.Errorf(
+					"key %q: HS256 key must be at least %d bytes", jwk.Kid,
+					minHmacKeySize,
+				)
+			}
+			keys[jwk.Kid] = k
+		}
+	}
+	if 0 == len(keys) {
+		return nil, errors.New("no signing keys in the key set")
+	}
+	return keys, nil
+}
+
+// ApiKeyAuthenticator validates static API keys sent in the `X-API-Key`
+// header.
+type ApiKeyAuthenticator struct {
+	// subjects maps SHA-256 hashes of keys to subjects, so that looking up
+	// keys doesn't leak their content through timing.
+	subjects map[[sha256.Size]byte]string
+}
+
+// NewApiKeyAuthenticator returns an authenticator accepting the given keys,
+// mapped to their subjects.
+func NewApiKeyAuthenticator(keys map[string]string) *ApiKeyAuthenticator {
+	subjects := make(map[[sha256.Size]byte]string, len(keys))
+	for key, subject := range keys {
+		subjects[sha256.Sum256([]byte(key))] = subject
+	}
+	return &ApiKeyAuthenticator{subjects: subjects}
+}
+
+func (a *ApiKeyAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	key := req.Header.Get(HeaderApiKey)
+	if "" == key {
+		return nil, errNoCredentials
+	}
+	subject, ok := a.subjects[sha256.Sum256([]byte(key))]
+	if !ok {
+		return nil, errors.New("invalid API key")
+	}
+	return &Principal{Subject: subject, Method: "api_key"}, nil
+}
+
+// loadApiKeysFile loads API keys from a file, one `subject:key` pair per line.
+// Empty lines and lines starting with `#` are ignored.
+func loadApiKeysFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+	keys := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for no := 1; scanner.Scan(); no++ {
+		line := strings.TrimSpace(scanner.Text())
+		if "" == line || strings.HasPrefix(line, "#") {
+			continue
+		}
+		subject, key, found := strings.Cut(line, ":")
+		if !found || "" == subject || "" == key {
+			return nil, fmt.Errorf("line %d: expecting `subject:key`", no)
+		}
+		keys[key] = subject
+	}
+	return keys, scanner.Err()
+}
+
+// getAuthenticators returns authenticators of the configuration.
+// Authentication is disabled if none is configured.
+func getAuthenticators(cfg AuthConfig) ([]Authenticator, error) {
+	var authenticators []Authenticator
+	keys := map[string]interface{}{}
+	if path := cfg.JWTKeyFile; "" != path {
+		key, err := loadJWTKeyFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("AUTH_JWT_KEY_FILE: %w", err)
+		}
+		keys[""] = key
+	}
+	if path := cfg.JWKSFile; "" != path {
+		set, err := loadJWKSFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("AUTH_JWKS_FILE: %w", err)
+		}
+		for kid, key := range set {
+			keys[kid] = key
+		}
+	}
+	if len(keys) > 0 {
+		authenticators = append(
+			authenticators, NewJWTAuthenticator(
+				keys, cfg.JWTIssuer, cfg.JWTAudience,
+			),
+		)
+	}
+	if path := cfg.APIKeysFile; "" != path {
+		apiKeys, err := loadApiKeysFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("AUTH_API_KEYS_FILE: %w", err)
+		}
+		authenticators = append(authenticators, NewApiKeyAuthenticator(apiKeys))
+	}
+	return authenticators, nil
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+const testHmacKey = "0123456789abcdef0123456789abcdef"
+
+func writeTestFile(tb testing.TB, name, content string) string {
+	path := filepath.Join(tb.TempDir(), name)
+	assert.Nil(tb, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func signTestToken(
+	tb testing.TB, method jwt.SigningMethod, key interface{}, kid string,
+	claims jwt.RegisteredClaims,
+) string {
+	token := jwt.NewWithClaims(method, claims)
+	if "" != kid {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	assert.Nil(tb, err)
+	return signed
+}
+
+func validClaims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "alice",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func Test_auth_accepts_hs256_token_and_records_subject(t *testing.T) {
+	t.Setenv("AUTH_JWT_KEY_FILE", writeTestFile(t, "key", testHmacKey+"\n"))
+	t.Setenv("AUTH_ADMINS", "alice")
+	_, engine, entClient, _ := setupGinTest(t)
+	token := signTestToken(
+		t, jwt.SigningMethodHS256, []byte(testHmacKey), "", validClaims(),
+	)
+	req := httptest.NewRequest(
+		http.MethodPatch, schema.BaseUri+"/2",
+		strings.NewReader(`{"name":"new name"}`),
+	)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set(HeaderActor, "mallory")
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	rec := entClient.ItemHistory.Query().Where(
+		itemhistory.ItemID(2),
+		itemhistory.ActionEQ(itemhistory.ActionUpdate),
+	).OnlyX(context.Background())
+	assert.Equal(t, "alice", *rec.Actor)
+}
+
+func Test_auth_reports_401_without_credentials(t *testing.T) {
+	t.Setenv("AUTH_JWT_KEY_FILE", writeTestFile(t, "key", testHmacKey))
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodDelete, schema.BaseUri+"/2", "")
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	assert.Contains(t, res.Header().Get("WWW-Authenticate"), "Bearer")
+	var actual N401ApplicationJSON
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, http.StatusUnauthorized, actual.Code)
+}
+
+func Test_auth_rejects_invalid_tokens(t *testing.T) {
+	t.Setenv("AUTH_JWT_KEY_FILE", writeTestFile(t, "key", testHmacKey))
+	t.Setenv("AUTH_JWT_ISSUER", "issuer")
+	_, engine, _, _ := setupGinTest(t)
+	expired := validClaims()
+	expired.Issuer = "issuer"
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := validClaims()
+	noExpiry.Issuer = "issuer"
+	noExpiry.ExpiresAt = nil
+	key := []byte(testHmacKey)
+	tokens := map[string]string{
+		"expired": signTestToken(t, jwt.SigningMethodHS256, key, "", expired),
+		"no expiry": signTestToken(
+			t, jwt.SigningMethodHS256, key, "", noExpiry,
+		),
+		"wrong issuer": signTestToken(
+			t, jwt.SigningMethodHS256, key, "", validClaims(),
+		),
+		"wrong key": signTestToken(
+			t, jwt.SigningMethodHS256, []byte(strings.Repeat("x", 32)), "",
+			validClaims(),
+		),
+		"malformed": "not.a.token",
+	}
+	for name, token := range tokens {
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+"/1", "",
+			withHeader("Authorization", "Bearer "+token),
+		)
+		assert.Equal(t, http.StatusUnauthorized, res.Code, name)
+	}
+}
+
+func Test_auth_accepts_rs256_token_from_pem_key_file(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.Nil(t, err)
+	block := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	t.Setenv("AUTH_JWT_KEY_FILE", writeTestFile(t, "key.pem", string(block)))
+	t.Setenv("AUTH_ADMINS", "alice")
+	_, engine, _, _ := setupGinTest(t)
+	token := signTestToken(t, jwt.SigningMethodRS256, key, "", validClaims())
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1", "",
+		withHeader("Authorization", "Bearer "+token),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	// the public key must not be usable as an HS256 secret
+	token = signTestToken(t, jwt.SigningMethodHS256, block, "", validClaims())
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1", "",
+		withHeader("Authorization", "Bearer "+token),
+	)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_auth_selects_jwks_key_by_kid(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	enc := base64.RawURLEncoding
+	jwks := `{"keys":[` +
+		`{"kty":"EC","kid":"ec","crv":"P-256","x":"","y":""},` +
+		`{"kty":"RSA","kid":"rsa","use":"sig","n":"` +
+		enc.EncodeToString(key.N.Bytes()) + `","e":"` +
+		enc.EncodeToString(big.NewInt(int64(key.E)).Bytes()) + `"},` +
+		`{"kty":"oct","kid":"hmac","k":"` +
+		enc.EncodeToString([]byte(testHmacKey)) + `"}]}`
+	t.Setenv("AUTH_JWKS_FILE", writeTestFile(t, "jwks.json", jwks))
+	t.Setenv("AUTH_ADMINS", "alice")
+	_, engine, _, _ := setupGinTest(t)
+	tests := []struct {
+		method jwt.SigningMethod
+		key    interface{}
+		kid    string
+		code   int
+	}{
+		{jwt.SigningMethodRS256, key, "rsa", http.StatusOK},
+		{jwt.SigningMethodHS256, []byte(testHmacKey), "hmac", http.StatusOK},
+		{jwt.SigningMethodRS256, key, "other", http.StatusUnauthorized},
+		{jwt.SigningMethodRS256, key, "", http.StatusUnauthorized},
+		// the kid decides the key, so it has to match the algorithm
+		{jwt.SigningMethodHS256, []byte(testHmacKey), "rsa", http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		token := signTestToken(t, test.method, test.key, test.kid, validClaims())
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+"/1", "",
+			withHeader("Authorization", "Bearer "+token),
+		)
+		assert.Equal(t, test.code, res.Code, test.kid)
+	}
+}
+
+func Test_auth_accepts_api_keys(t *testing.T) {
+	t.Setenv(
+		"AUTH_API_KEYS_FILE",
+		writeTestFile(t, "keys", "# CI pipeline\nci:secret-key\n\n"),
+	)
+	t.Setenv("AUTH_ADMINS", "ci")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1", "",
+		withHeader(HeaderApiKey, "secret-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1", "",
+		withHeader(HeaderApiKey, "wrong-key"),
+	)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_auth_fails_on_invalid_configuration(t *testing.T) {
+	t.Setenv("AUTH_JWT_KEY_FILE", writeTestFile(t, "key", "too short"))
+	_, _, err := newEngine(nil, testConfig(t))
+	assert.ErrorContains(t, err, "AUTH_JWT_KEY_FILE")
+	t.Setenv("AUTH_JWT_KEY_FILE", "")
+	t.Setenv("AUTH_API_KEYS_FILE", writeTestFile(t, "keys", "no-subject"))
+	_, _, err = newEngine(nil, testConfig(t))
+	assert.ErrorContains(t, err, "AUTH_API_KEYS_FILE")
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// errForbidden is returned if the principal lacks the permission required.
+var errForbidden = errors.New("permission denied")
+
+// errNotReadable is returned for items the principal cannot read, which are
+// reported as not found to hide their existence.
+var errNotReadable = errors.New("item not found")
+
+// permissionLevels orders permissions, each one includes those below it.
+var permissionLevels = map[string]int{
+	schema.PermissionRead:  1,
+	schema.PermissionWrite: 2,
+	schema.PermissionAdmin: 3,
+}
+
+// isSuperuser returns true if the principal is allowed everything, which is
+// the case for administrators, and all requests if authentication is disabled.
+func (s Server) isSuperuser(ctx context.Context) bool {
+	p := PrincipalFromContext(ctx)
+	return nil == p || slices.Contains(s.Admins, p.Subject)
+}
+
+// lineage returns IDs of the item and all its ancestors, or nothing if the item
+// doesn't exist.
+func lineage(
+	ctx context.Context, client *ent.Client, id uint32,
+) ([]uint32, error) {
+	// trashed items are still part of the tree, so walk through them too
+	qc := softdelete.IncludeTrashed(ctx)
+	var ids []uint32
+	for pid := &id; nil != pid && !slices.Contains(ids, *pid); {
+		row, err := client.Item.Get(qc, *pid)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				break
+			}
+			return nil, err
+		}
+		ids = append(ids, row.ID)
+		pid = row.ParentID
+	}
+	return ids, nil
+}
+
+// readers returns subjects granted any permission on the item or any of its
+// ancestors, i.e. those who can read the item, apart from superusers.
+func readers(
+	ctx context.Context, client *ent.Client, id uint32,
+) ([]string, error) {
+	ids, err := lineage(ctx, client, id)
+	if err != nil || 0 == len(ids) {
+		return nil, err
+	}
+	return client.Grant.Query().Where(grant.ItemIDIn(ids...)).Unique(true).
+		Select(grant.FieldSubject).Strings(ctx)
+}
+
+// permissionLevel returns the highest level of permissions granted to the
+// principal on the item or any of its ancestors, or 0 if there's none.
+func permissionLevel(
+	ctx context.Context, client *ent.Client, subject string, id uint32,
+) (int, error) {
+	ids, err := lineage(ctx, client, id)
+	if err != nil || 0 == len(ids) {
+		return 0, err
+	}
+	grants, err := client.Grant.Query().
+		Where(grant.Subject(subject), grant.ItemIDIn(ids...)).All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	level := 0
+	for _, g := range grants {
+		level = max(level, permissionLevels[g.Permission.String()])
+	}
+	return level, nil
+}
+
+// authorize returns errNotReadable if the principal cannot read the item, or
+// errForbidden if it can read the item but lacks the given permission.
+func (s Server) authorize(
+	ctx context.Context, client *ent.Client, id uint32, permission string,
+) error {
+	if s.isSuperuser(ctx) {
+		return nil
+	}
+	level, err := permissionLevel(
+		ctx, client, PrincipalFromContext(ctx).Subject, id,
+	)
+	if err != nil {
+		return err
+	}
+	if level < permissionLevels[schema.PermissionRead] {
+		return errNotReadable
+	}
+	if level < permissionLevels[permission] {
+		return errForbidden
+	}
+	return nil
+}
+
+// authorizeParent checks write permission on the item that is going to be the
+// parent of another. Only superusers can create root items.
+func (s Server) authorizeParent(
+	ctx context.Context, client *ent.Client, parentId *uint32,
+) error {
+	if nil != parentId {
+		return s.authorize(ctx, client, *parentId, schema.PermissionWrite)
+	}
+	if s.isSuperuser(ctx) {
+		return nil
+	}
+	return errForbidden
+}
+
+// authorizeSuperuser returns errForbidden unless the principal is a superuser.
+func (s Server) authorizeSuperuser(ctx context.Context) error {
+	if s.isSuperuser(ctx) {
+		return nil
+	}
+	return errForbidden
+}
+
+// readable returns a predicate matching rows whose `column` is the ID of an
+// item readable by the principal, i.e. in the subtree of any item granted to
+// it. Nothing is filtered for superusers.
+func (s Server) readable(ctx context.Context, column string) func(*sql.Selector) {
+	if s.isSuperuser(ctx) {
+		return func(*sql.Selector) {}
+	}
+	subject := PrincipalFromContext(ctx).Subject
+	return func(stmt *sql.Selector) {
+		items := sql.Table(item.Table)
+		grants := sql.Table(grant.Table)
+		view := sql.Table("readable")
+		cte := sql.WithRecursive("readable", item.FieldID)
+		cte.As(
+			sql.Select(grants.C(grant.FieldItemID)).From(grants).
+				Where(sql.EQ(grants.C(grant.FieldSubject), subject)).
+				Union(
+					sql.Select(items.C(item.FieldID)).From(items).
+						Join(view).
+						On(items.C(item.ParentColumn), view.C(item.FieldID)),
+				),
+		)
+		stmt.Where(
+			sql.In(
+				stmt.C(column),
+				sql.Select(view.C(item.FieldID)).From(view).Prefix(cte),
+			),
+		)
+	}
+}
+
+// filterReadable removes items the principal cannot read.
+func (s Server) filterReadable(
+	ctx context.Context, items []*ent.Item,
+) ([]*ent.Item, error) {
+	if s.isSuperuser(ctx) || 0 == len(items) {
+		return items, nil
+	}
+	ids := make([]uint32, len(items))
+	for i, row := range items {
+		ids[i] = row.ID
+	}
+	readable, err := s.EC.Item.Query().
+		Where(item.IDIn(ids...), s.readable(ctx, item.FieldID)).
+		IDs(softdelete.IncludeTrashed(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(
+		items, func(row *ent.Item) bool {
+			return !slices.Contains(readable, row.ID)
+		},
+	), nil
+}
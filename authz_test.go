@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// setupAuthzTest authenticates `root` as administrator, `bob` with read
+// permission on item 1 and write permission on item 2, and `eve` without any
+// grant. Items 2 & 3 are children of item 1, and item 4 is child of item 2.
+func setupAuthzTest(tb testing.TB) (*Server, *gin.Engine, *ent.Client) {
+	tb.Setenv(
+		"AUTH_API_KEYS_FILE", writeTestFile(
+			tb, "keys", "root:root-key\nbob:bob-key\neve:eve-key\n",
+		),
+	)
+	tb.Setenv("AUTH_ADMINS", "root")
+	server, engine, entClient, _ := setupGinTest(tb)
+	ctx := context.Background()
+	entClient.Item.Update().Where(item.IDIn(2, 3)).SetParentID(1).SaveX(ctx)
+	entClient.Item.UpdateOneID(4).SetParentID(2).SaveX(ctx)
+	entClient.Grant.Create().SetItemID(1).SetSubject("bob").
+		SetPermission(grant.PermissionRead).SaveX(ctx)
+	entClient.Grant.Create().SetItemID(2).SetSubject("bob").
+		SetPermission(grant.PermissionWrite).SaveX(ctx)
+	return server, engine, entClient
+}
+
+func Test_authz_lists_readable_items_only(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri, "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var page struct {
+		Total int
+		Data  []Item
+	}
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Equal(t, 4, page.Total)
+	ids := make([]uint32, len(page.Data))
+	for i, row := range page.Data {
+		ids[i] = row.Id
+	}
+	assert.ElementsMatch(t, []uint32{1, 2, 3, 4}, ids)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri, "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Equal(t, 0, page.Total)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri, "",
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Equal(t, 50, page.Total)
+}
+
+func Test_authz_lists_children_granted_below_requested_item(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	ctx := context.Background()
+	entClient.Item.UpdateOneID(5).SetParentID(4).SaveX(ctx)
+	entClient.Grant.Create().SetItemID(4).SetSubject("eve").
+		SetPermission(grant.PermissionRead).SaveX(ctx)
+	tests := []struct {
+		url string
+		ids []uint32
+	}{
+		{"/1/children", []uint32{}},
+		{"/1/children?recurse=1", []uint32{4, 5}},
+		{"/2/children", []uint32{4}},
+		{"/4/children?recurse=1", []uint32{5}},
+	}
+	for _, test := range tests {
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+test.url, "",
+			withHeader(HeaderApiKey, "eve-key"),
+		)
+		assert.Equal(t, http.StatusOK, res.Code, test.url)
+		var page struct{ Data []Item }
+		assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+		ids := make([]uint32, len(page.Data))
+		for i, row := range page.Data {
+			ids[i] = row.Id
+		}
+		assert.Equal(t, test.ids, ids, test.url)
+	}
+}
+
+func Test_authz_reports_404_for_unreadable_items(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	tests := []struct {
+		key, url string
+		code     int
+	}{
+		{"bob-key", "/4", http.StatusOK},
+		{"bob-key", "/4/parent", http.StatusOK},
+		{"bob-key", "/5", http.StatusNotFound},
+		{"bob-key", "/5/history", http.StatusNotFound},
+		{"eve-key", "/1", http.StatusNotFound},
+		{"root-key", "/5", http.StatusOK},
+	}
+	for _, test := range tests {
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+test.url, "",
+			withHeader(HeaderApiKey, test.key),
+		)
+		assert.Equal(t, test.code, res.Code, test.key+" "+test.url)
+	}
+}
+
+func Test_authz_requires_write_permission_to_change_items(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/4", `{"name":"new name"}`,
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/3", `{"name":"new name"}`,
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	assert.Equal(t, "name 2", entClient.Item.GetX(context.Background(), 3).Name)
+	// moving needs write permission on the new parent too
+	res = serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/4", `{"parent_id":3}`,
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	res = serveRequest(
+		engine, http.MethodPost, schema.BaseUri,
+		`{"name":"child","parent_id":2}`, withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	res = serveRequest(
+		engine, http.MethodPost, schema.BaseUri,
+		`{"name":"child","parent_id":1}`, withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_authz_forbids_root_items_to_non_administrators(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"root"}`,
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	res = serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"root"}`,
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+}
+
+func Test_authz_requires_admin_permission_to_purge(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/4", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	res = serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/4?trashed=1", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	entClient.Grant.Update().Where(grant.ItemID(2)).
+		SetPermission(grant.PermissionAdmin).SaveX(context.Background())
+	res = serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/4?trashed=1", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+}
+
+func Test_authz_forbids_webhooks_to_non_administrators(t *testing.T) {
+	_, engine, _ := setupAuthzTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/webhooks", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/webhooks", "",
+		withHeader(HeaderApiKey, "root-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// rebasedRouter registers routes of the spec relative to the router group,
+// whose path is the configured `BASE_URI`.
+type rebasedRouter struct {
+	*gin.RouterGroup
+}
+
+var _ gin.IRouter = rebasedRouter{}
+
+func (r rebasedRouter) Handle(
+	method, path string, handlers ...gin.HandlerFunc,
+) gin.IRoutes {
+	// the root is served with a trailing slash as well, which is what clients
+	// resolving paths against the base URI request
+	if "/" == path && "/" != r.BasePath() {
+		r.RouterGroup.Handle(method, path, handlers...)
+	}
+	return r.RouterGroup.Handle(method, rebasePath(path), handlers...)
+}
+
+func (r rebasedRouter) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodGet, path, handlers...)
+}
+
+func (r rebasedRouter) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPost, path, handlers...)
+}
+
+func (r rebasedRouter) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPut, path, handlers...)
+}
+
+func (r rebasedRouter) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPatch, path, handlers...)
+}
+
+func (r rebasedRouter) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodDelete, path, handlers...)
+}
+
+// rebasePath returns the path of the spec relative to the base URI. The root
+// path of the spec is the base URI itself, without a trailing slash.
+func rebasePath(path string) string {
+	if "/" == path {
+		return ""
+	}
+	return path
+}
+
+// routePath returns the route the request is matched to. The root is reported
+// without trailing slash, in whichever form it's requested.
+func routePath(gc *gin.Context) string {
+	route := gc.FullPath()
+	if len(route) > 1 {
+		return strings.TrimSuffix(route, "/")
+	}
+	return route
+}
+
+// ginPath returns the gin route of the path of the spec, e.g. `/:id` of
+// `/{id}`.
+func ginPath(path string) string {
+	return strings.NewReplacer("{", ":", "}", "").Replace(path)
+}
+
+// rebaseSpec prepends the given base URI to paths of the spec, so that the
+// spec describes routes actually served.
+func rebaseSpec(swagger *openapi3.T, baseUri string) {
+	paths := openapi3.NewPaths()
+	for path, item := range swagger.Paths.Map() {
+		path = baseUri + rebasePath(path)
+		if "" == path {
+			path = "/"
+		}
+		paths.Set(path, item)
+	}
+	swagger.Paths = paths
+}
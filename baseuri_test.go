@@ -0,0 +1,85 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_BASE_URI_mounts_endpoints_under_the_prefix(t *testing.T) {
+	t.Setenv("BASE_URI", "/api/v1/tree/")
+	server, engine, _, _ := setupGinTest(t)
+	assert.Equal(t, "http://localhost/api/v1/tree", server.BaseUrl())
+	res := serveRequest(engine, http.MethodGet, "/api/v1/tree/1", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/api/v1/tree/1/children", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodGet, schema.BaseUri+"/1", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/api/v1/tree?per_page=10", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	var page paginate.PaginatedList[Item]
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Equal(t, server.BaseUrl(), page.Path)
+	assert.Equal(
+		t, server.BaseUrl()+"?page=2&per_page=10", page.NextPageUrl,
+	)
+}
+
+func Test_BASE_URI_can_be_the_root(t *testing.T) {
+	t.Setenv("BASE_URI", "/")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/1", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/webhooks", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/healthz", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_BASE_URI_serves_the_root_with_trailing_slash(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodPost, schema.BaseUri+"/", `{"name":"new"}`,
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/?per_page=x", "",
+	)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_rebaseSpec_rewrites_paths(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.Nil(t, err)
+	count := swagger.Paths.Len()
+	rebaseSpec(swagger, "/api")
+	assert.Equal(t, count, swagger.Paths.Len())
+	assert.NotNil(t, swagger.Paths.Value("/api"))
+	assert.NotNil(t, swagger.Paths.Value("/api/{id}/children"))
+	assert.Nil(t, swagger.Paths.Value(schema.BaseUri+"/{id}"))
+	names := operationNames(swagger)
+	assert.Equal(t, "ReadItem", names["GET /api/:id"])
+	swagger, err = GetSwagger()
+	assert.Nil(t, err)
+	rebaseSpec(swagger, "")
+	assert.NotNil(t, swagger.Paths.Value("/"))
+	assert.NotNil(t, swagger.Paths.Value("/{id}"))
+}
+
+func Test_BASE_URI_must_be_a_plain_path(t *testing.T) {
+	setTestEnv(t)
+	for _, uri := range []string{"api", "/api/:id", "/api?x"} {
+		t.Setenv("BASE_URI", uri)
+		_, err := loadConfig(nil)
+		assert.ErrorContains(t, err, "BASE_URI", uri)
+	}
+}
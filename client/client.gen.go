@@ -17,6 +17,100 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ItemEdge.
+const (
+	Ancestors ItemEdge = "ancestors"
+	Children  ItemEdge = "children"
+	Parent    ItemEdge = "parent"
+)
+
+// Defines values for ItemField.
+const (
+	CreatedAt ItemField = "created_at"
+	DeletedAt ItemField = "deleted_at"
+	Id        ItemField = "id"
+	Name      ItemField = "name"
+	ParentId  ItemField = "parent_id"
+	UpdatedAt ItemField = "updated_at"
+)
+
+// Defines values for ItemHistoryAction.
+const (
+	Create  ItemHistoryAction = "create"
+	Delete  ItemHistoryAction = "delete"
+	Purge   ItemHistoryAction = "purge"
+	Restore ItemHistoryAction = "restore"
+	Revert  ItemHistoryAction = "revert"
+	Update  ItemHistoryAction = "update"
+)
+
+// Defines values for Permission.
+const (
+	Admin Permission = "admin"
+	Read  Permission = "read"
+	Write Permission = "write"
+)
+
+// Defines values for Trashed.
+const (
+	False   Trashed = "false"
+	N0      Trashed = "0"
+	N1      Trashed = "1"
+	Only    Trashed = "only"
+	True    Trashed = "true"
+	With    Trashed = "with"
+	Without Trashed = "without"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Dead      WebhookDeliveryStatus = "dead"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for ListItemParamsPagination.
+const (
+	ListItemParamsPaginationCursor ListItemParamsPagination = "cursor"
+	ListItemParamsPaginationOffset ListItemParamsPagination = "offset"
+)
+
+// Defines values for ListItemChildrenParamsPagination.
+const (
+	ListItemChildrenParamsPaginationCursor ListItemChildrenParamsPagination = "cursor"
+	ListItemChildrenParamsPaginationOffset ListItemChildrenParamsPagination = "offset"
+)
+
+// Defines values for ListItemSiblingsParamsPagination.
+const (
+	ListItemSiblingsParamsPaginationCursor ListItemSiblingsParamsPagination = "cursor"
+	ListItemSiblingsParamsPaginationOffset ListItemSiblingsParamsPagination = "offset"
+)
+
+// Cursor Opaque position of a page in cursor pagination
+type Cursor = string
+
+// Grant defines model for Grant.
+type Grant struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        uint32     `json:"id"`
+
+	// ItemId ID of the item granted
+	ItemId uint32 `json:"item_id"`
+
+	// Permission Permission granted, `write` includes `read`, and `admin` includes both
+	Permission Permission `json:"permission"`
+
+	// Subject Subject granted, as authenticated
+	Subject   string     `json:"subject"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
 // Item defines model for Item.
 type Item struct {
 	Children  *[]Item    `json:"children,omitempty"`
@@ -32,6 +126,15 @@ type Item struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// ItemCopy Result of copying an Item with its descendants
+type ItemCopy struct {
+	// Id ID of the copy of the Item
+	Id uint32 `json:"id"`
+
+	// Ids IDs of copies, keyed by IDs of the Items copied
+	Ids map[string]uint32 `json:"ids"`
+}
+
 // ItemCreate defines model for ItemCreate.
 type ItemCreate struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -45,14 +148,57 @@ type ItemCreate struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// ItemEdge Edge of items
+type ItemEdge string
+
+// ItemField Field of items
+type ItemField string
+
+// ItemHistory defines model for ItemHistory.
+type ItemHistory struct {
+	Action    ItemHistoryAction `json:"action"`
+	Actor     *string           `json:"actor,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Id        uint64            `json:"id"`
+
+	// ItemId ID of the changed item
+	ItemId     uint32  `json:"item_id"`
+	NameAfter  *string `json:"name_after,omitempty"`
+	NameBefore *string `json:"name_before,omitempty"`
+
+	// ParentAfter Parent ID after the change
+	ParentAfter *uint32 `json:"parent_after,omitempty"`
+
+	// ParentBefore Parent ID before the change
+	ParentBefore *uint32 `json:"parent_before,omitempty"`
+	RequestId    *string `json:"request_id,omitempty"`
+
+	// Revision Revision number of the item, starting from 1
+	Revision uint32 `json:"revision"`
+}
+
+// ItemHistoryAction defines model for ItemHistory.Action.
+type ItemHistoryAction string
+
 // ItemList defines model for ItemList.
 type ItemList struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Id        uint32     `json:"id"`
+	// Ancestors Readable ancestors of the item, root first, if expanded
+	Ancestors *[]ItemList `json:"ancestors,omitempty"`
+
+	// Children Children of the item, if expanded
+	Children  *[]ItemList `json:"children,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+
+	// DeletedAt Date and time when the record was deleted
+	DeletedAt nullable.Nullable[time.Time] `json:"deleted_at,omitempty"`
+	Id        uint32                       `json:"id"`
 
 	// Name Item name
 	Name string `json:"name"`
 
+	// Parent Parent of the item, if expanded and readable
+	Parent *ItemList `json:"parent,omitempty"`
+
 	// ParentId Parent record ID
 	ParentId  *uint32    `json:"parent_id,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
@@ -60,7 +206,12 @@ type ItemList struct {
 
 // ItemRead defines model for ItemRead.
 type ItemRead struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Ancestors Readable ancestors of the item, root first, if expanded
+	Ancestors *[]ItemList `json:"ancestors,omitempty"`
+
+	// Children Children of the item, if expanded
+	Children  *[]ItemList `json:"children,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
 
 	// DeletedAt Date and time when the record was deleted
 	DeletedAt nullable.Nullable[time.Time] `json:"deleted_at,omitempty"`
@@ -69,11 +220,41 @@ type ItemRead struct {
 	// Name Item name
 	Name string `json:"name"`
 
+	// Parent Parent of the item, if expanded and readable
+	Parent *ItemList `json:"parent,omitempty"`
+
 	// ParentId Parent record ID
 	ParentId  *uint32    `json:"parent_id,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// ItemRelation How two Items relate in the tree
+type ItemRelation struct {
+	// Ancestor Whether the Item is an ancestor of the other
+	Ancestor bool `json:"ancestor"`
+
+	// Descendant Whether the Item is a descendant of the other
+	Descendant bool `json:"descendant"`
+
+	// Distance Number of edges on the path between the Items, absent if they are unrelated
+	Distance *int `json:"distance,omitempty"`
+
+	// Id ID of the Item
+	Id uint32 `json:"id"`
+
+	// Lca Lowest common ancestor, absent if the Items are unrelated, or it is not readable
+	Lca *ItemList `json:"lca,omitempty"`
+
+	// Other ID of the other Item
+	Other uint32 `json:"other"`
+
+	// Path Readable Items on the path from the Item up to the lowest common ancestor and down to the other, both inclusive. Empty if unrelated.
+	Path []ItemList `json:"path"`
+
+	// Related Whether both Items are in the same tree
+	Related bool `json:"related"`
+}
+
 // ItemUpdate defines model for ItemUpdate.
 type ItemUpdate struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -90,7 +271,10 @@ type ItemUpdate struct {
 // ItemParentRead defines model for Item_ParentRead.
 type ItemParentRead struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Id        uint32     `json:"id"`
+
+	// DeletedAt Date and time when the record was deleted
+	DeletedAt nullable.Nullable[time.Time] `json:"deleted_at,omitempty"`
+	Id        uint32                       `json:"id"`
 
 	// Name Item name
 	Name string `json:"name"`
@@ -100,34 +284,210 @@ type ItemParentRead struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
-// N400 defines model for 400.
-type N400 struct {
+// Permission Permission granted, `write` includes `read`, and `admin` includes both
+type Permission string
+
+// Problem Problem details of an error, as defined by RFC 7807
+type Problem struct {
+	// Detail Explanation of the occurrence
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance Path of the request
+	Instance *string `json:"instance,omitempty"`
+
+	// InvalidParams Parameters or properties failing validation
+	InvalidParams *[]struct {
+		// In path, query, header or body
+		In *string `json:"in,omitempty"`
+
+		// Name Parameter name, or dot separated path of the property
+		Name *string `json:"name,omitempty"`
+
+		// Reason What is wrong
+		Reason string `json:"reason"`
+	} `json:"invalid-params,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI of the problem type, documented in README
+	Type string `json:"type"`
+}
+
+// Trashed Whether trashed items are read. `1` and `true` are deprecated aliases of `with`, `0` and `false` of `without`.
+type Trashed string
+
+// WebhookCreate defines model for WebhookCreate.
+type WebhookCreate struct {
+	Active    bool       `json:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty"`
+	Id        uint32     `json:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  int64     `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+	Event     string    `json:"event"`
+
+	// EventId ID of the event
+	EventId       uint64                `json:"event_id"`
+	Id            uint64                `json:"id"`
+	LastError     *string               `json:"last_error,omitempty"`
+	NextAttemptAt time.Time             `json:"next_attempt_at"`
+	Payload       string                `json:"payload"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+
+	// WebhookId ID of the webhook to deliver to
+	WebhookId uint32 `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Active    bool       `json:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty"`
+	Id        uint32     `json:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
+}
+
+// WebhookRead defines model for WebhookRead.
+type WebhookRead struct {
+	Active    bool       `json:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty"`
+	Id        uint32     `json:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
+}
+
+// WebhookUpdate defines model for WebhookUpdate.
+type WebhookUpdate struct {
+	Active    bool       `json:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty"`
+	Id        uint32     `json:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
+}
+
+// N400ApplicationJSON defines model for 400.
+type N400ApplicationJSON struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
+
+// N400ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N400ApplicationProblemPlusJSON = Problem
+
+// N401ApplicationJSON defines model for 401.
+type N401ApplicationJSON struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
+
+// N401ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N401ApplicationProblemPlusJSON = Problem
+
+// N403ApplicationJSON defines model for 403.
+type N403ApplicationJSON struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
+
+// N403ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N403ApplicationProblemPlusJSON = Problem
+
+// N404ApplicationJSON defines model for 404.
+type N404ApplicationJSON struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
+
+// N404ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N404ApplicationProblemPlusJSON = Problem
+
+// N409ApplicationJSON defines model for 409.
+type N409ApplicationJSON struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
+
+// N409ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N409ApplicationProblemPlusJSON = Problem
+
+// N413ApplicationJSON defines model for 413.
+type N413ApplicationJSON struct {
 	Code   int          `json:"code"`
 	Errors *interface{} `json:"errors,omitempty"`
 	Status string       `json:"status"`
 }
 
-// N404 defines model for 404.
-type N404 struct {
+// N413ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N413ApplicationProblemPlusJSON = Problem
+
+// N422ApplicationJSON defines model for 422.
+type N422ApplicationJSON struct {
 	Code   int          `json:"code"`
 	Errors *interface{} `json:"errors,omitempty"`
 	Status string       `json:"status"`
 }
 
-// N409 defines model for 409.
-type N409 struct {
+// N422ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N422ApplicationProblemPlusJSON = Problem
+
+// N429ApplicationJSON defines model for 429.
+type N429ApplicationJSON struct {
 	Code   int          `json:"code"`
 	Errors *interface{} `json:"errors,omitempty"`
 	Status string       `json:"status"`
 }
 
-// N500 defines model for 500.
-type N500 struct {
+// N429ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N429ApplicationProblemPlusJSON = Problem
+
+// N500ApplicationJSON defines model for 500.
+type N500ApplicationJSON struct {
 	Code   int          `json:"code"`
 	Errors *interface{} `json:"errors,omitempty"`
 	Status string       `json:"status"`
 }
 
+// N500ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N500ApplicationProblemPlusJSON = Problem
+
 // ListItemParams defines parameters for ListItem.
 type ListItemParams struct {
 	// Page what page to render
@@ -139,10 +499,28 @@ type ListItemParams struct {
 	// Name Name of the item
 	Name *string `form:"name,omitempty" json:"name,omitempty"`
 
-	// Trashed Whether to include trashed items
-	Trashed *bool `form:"trashed,omitempty" json:"trashed,omitempty"`
+	// Pagination Pagination mode, defaults to `offset`. `cursor` pagination neither counts nor skips items, and ignores `page`
+	Pagination *ListItemParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` or `prev_cursor` of a previous page, implying cursor pagination
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// AsOf Read items as they were at the given time, reconstructed from item history
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty"`
 }
 
+// ListItemParamsPagination defines parameters for ListItem.
+type ListItemParamsPagination string
+
 // CreateItemJSONBody defines parameters for CreateItem.
 type CreateItemJSONBody struct {
 	// Name Item name
@@ -152,6 +530,58 @@ type CreateItemJSONBody struct {
 	ParentId *uint32 `json:"parent_id,omitempty"`
 }
 
+// StreamItemEventsParams defines parameters for StreamItemEvents.
+type StreamItemEventsParams struct {
+	// LastEventID ID of the last event received
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// ListWebhookParams defines parameters for ListWebhook.
+type ListWebhookParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	Active *bool `json:"active,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events *[]string `json:"events,omitempty"`
+
+	// Secret Secret used to sign the payloads
+	Secret string `json:"secret"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
+}
+
+// UpdateWebhookJSONBody defines parameters for UpdateWebhook.
+type UpdateWebhookJSONBody struct {
+	Active *bool `json:"active,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events *[]string `json:"events,omitempty"`
+
+	// Secret Secret used to sign the payloads
+	Secret *string `json:"secret,omitempty"`
+
+	// Url URL receiving the events
+	Url *string `json:"url,omitempty"`
+}
+
+// ListWebhookDeadLetterParams defines parameters for ListWebhookDeadLetter.
+type ListWebhookDeadLetterParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
 // DeleteItemParams defines parameters for DeleteItem.
 type DeleteItemParams struct {
 	// Trashed Whether to include trashed items
@@ -160,8 +590,17 @@ type DeleteItemParams struct {
 
 // ReadItemParams defines parameters for ReadItem.
 type ReadItemParams struct {
-	// Trashed Whether to include trashed items
-	Trashed *bool `form:"trashed,omitempty" json:"trashed,omitempty"`
+	// AsOf Read items as they were at the given time, reconstructed from item history
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty"`
 }
 
 // UpdateItemJSONBody defines parameters for UpdateItem.
@@ -186,14 +625,122 @@ type ListItemChildrenParams struct {
 
 	// Recurse Whether to return all descendants (recurse to last leaf)
 	Recurse *bool `form:"recurse,omitempty" json:"recurse,omitempty"`
+
+	// Pagination Pagination mode, defaults to `offset`. `cursor` pagination neither counts nor skips items, and ignores `page`
+	Pagination *ListItemChildrenParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` or `prev_cursor` of a previous page, implying cursor pagination
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// AsOf Read items as they were at the given time, reconstructed from item history
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty"`
+}
+
+// ListItemChildrenParamsPagination defines parameters for ListItemChildren.
+type ListItemChildrenParamsPagination string
+
+// CopyItemJSONBody defines parameters for CopyItem.
+type CopyItemJSONBody struct {
+	// Depth Levels of descendants to copy, 0 copies the Item only. All descendants are copied if omitted.
+	Depth *int `json:"depth,omitempty"`
+
+	// ParentId Parent of the copy, which is a root if omitted
+	ParentId *uint32 `json:"parent_id,omitempty"`
+
+	// Suffix Appended to the name of the copy of the Item, but not its descendants
+	Suffix *string `json:"suffix,omitempty"`
+}
+
+// SetItemGrantJSONBody defines parameters for SetItemGrant.
+type SetItemGrantJSONBody struct {
+	// Permission Permission granted, `write` includes `read`, and `admin` includes both
+	Permission Permission `json:"permission"`
+}
+
+// ListItemHistoryParams defines parameters for ListItemHistory.
+type ListItemHistoryParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// ReadItemParentParams defines parameters for ReadItemParent.
+type ReadItemParentParams struct {
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty"`
+}
+
+// RevertItemJSONBody defines parameters for RevertItem.
+type RevertItemJSONBody struct {
+	// Revision Revision number to revert to
+	Revision uint32 `json:"revision"`
+}
+
+// ListItemSiblingsParams defines parameters for ListItemSiblings.
+type ListItemSiblingsParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the item
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// ExcludeSelf Whether to leave the Item out of its siblings
+	ExcludeSelf *bool `form:"exclude_self,omitempty" json:"exclude_self,omitempty"`
+
+	// Pagination Pagination mode, defaults to `offset`. `cursor` pagination neither counts nor skips items, and ignores `page`
+	Pagination *ListItemSiblingsParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` or `prev_cursor` of a previous page, implying cursor pagination
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty"`
 }
 
+// ListItemSiblingsParamsPagination defines parameters for ListItemSiblings.
+type ListItemSiblingsParamsPagination string
+
 // CreateItemJSONRequestBody defines body for CreateItem for application/json ContentType.
 type CreateItemJSONRequestBody CreateItemJSONBody
 
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody UpdateWebhookJSONBody
+
 // UpdateItemJSONRequestBody defines body for UpdateItem for application/json ContentType.
 type UpdateItemJSONRequestBody UpdateItemJSONBody
 
+// CopyItemJSONRequestBody defines body for CopyItem for application/json ContentType.
+type CopyItemJSONRequestBody CopyItemJSONBody
+
+// SetItemGrantJSONRequestBody defines body for SetItemGrant for application/json ContentType.
+type SetItemGrantJSONRequestBody SetItemGrantJSONBody
+
+// RevertItemJSONRequestBody defines body for RevertItem for application/json ContentType.
+type RevertItemJSONRequestBody RevertItemJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -275,6 +822,31 @@ type ClientInterface interface {
 
 	CreateItem(ctx context.Context, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamItemEvents request
+	StreamItemEvents(ctx context.Context, params *StreamItemEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhook request
+	ListWebhook(ctx context.Context, params *ListWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReadWebhook request
+	ReadWebhook(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookWithBody request with any body
+	UpdateWebhookWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhook(ctx context.Context, id uint32, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeadLetter request
+	ListWebhookDeadLetter(ctx context.Context, id uint32, params *ListWebhookDeadLetterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteItem request
 	DeleteItem(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -289,11 +861,41 @@ type ClientInterface interface {
 	// ListItemChildren request
 	ListItemChildren(ctx context.Context, id uint32, params *ListItemChildrenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CopyItemWithBody request with any body
+	CopyItemWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CopyItem(ctx context.Context, id uint32, body CopyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListItemGrant request
+	ListItemGrant(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteItemGrant request
+	DeleteItemGrant(ctx context.Context, id uint32, subject string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetItemGrantWithBody request with any body
+	SetItemGrantWithBody(ctx context.Context, id uint32, subject string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetItemGrant(ctx context.Context, id uint32, subject string, body SetItemGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListItemHistory request
+	ListItemHistory(ctx context.Context, id uint32, params *ListItemHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ReadItemParent request
-	ReadItemParent(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ReadItemParent(ctx context.Context, id uint32, params *ReadItemParentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReadItemRelation request
+	ReadItemRelation(ctx context.Context, id uint32, other uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RestoreItem request
 	RestoreItem(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevertItemWithBody request with any body
+	RevertItemWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RevertItem(ctx context.Context, id uint32, body RevertItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListItemSiblings request
+	ListItemSiblings(ctx context.Context, id uint32, params *ListItemSiblingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ListItem(ctx context.Context, params *ListItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -332,8 +934,8 @@ func (c *Client) CreateItem(ctx context.Context, body CreateItemJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteItem(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteItemRequest(c.Server, id, params)
+func (c *Client) StreamItemEvents(ctx context.Context, params *StreamItemEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamItemEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -344,8 +946,8 @@ func (c *Client) DeleteItem(ctx context.Context, id uint32, params *DeleteItemPa
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReadItem(ctx context.Context, id uint32, params *ReadItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReadItemRequest(c.Server, id, params)
+func (c *Client) ListWebhook(ctx context.Context, params *ListWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -356,8 +958,8 @@ func (c *Client) ReadItem(ctx context.Context, id uint32, params *ReadItemParams
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateItemWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateItemRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -368,8 +970,116 @@ func (c *Client) UpdateItemWithBody(ctx context.Context, id uint32, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateItem(ctx context.Context, id uint32, body UpdateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateItemRequest(c.Server, id, body)
+func (c *Client) CreateWebhook(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadWebhook(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadWebhookRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhook(ctx context.Context, id uint32, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWebhookDeadLetter(ctx context.Context, id uint32, params *ListWebhookDeadLetterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeadLetterRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteItem(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteItemRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadItem(ctx context.Context, id uint32, params *ReadItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadItemRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateItemWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateItemRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateItem(ctx context.Context, id uint32, body UpdateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateItemRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -392,8 +1102,104 @@ func (c *Client) ListItemChildren(ctx context.Context, id uint32, params *ListIt
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReadItemParent(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReadItemParentRequest(c.Server, id)
+func (c *Client) CopyItemWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyItemRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CopyItem(ctx context.Context, id uint32, body CopyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCopyItemRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListItemGrant(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListItemGrantRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteItemGrant(ctx context.Context, id uint32, subject string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteItemGrantRequest(c.Server, id, subject)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetItemGrantWithBody(ctx context.Context, id uint32, subject string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetItemGrantRequestWithBody(c.Server, id, subject, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetItemGrant(ctx context.Context, id uint32, subject string, body SetItemGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetItemGrantRequest(c.Server, id, subject, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListItemHistory(ctx context.Context, id uint32, params *ListItemHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListItemHistoryRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadItemParent(ctx context.Context, id uint32, params *ReadItemParentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadItemParentRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadItemRelation(ctx context.Context, id uint32, other uint32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadItemRelationRequest(c.Server, id, other)
 	if err != nil {
 		return nil, err
 	}
@@ -416,6 +1222,42 @@ func (c *Client) RestoreItem(ctx context.Context, id uint32, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
+func (c *Client) RevertItemWithBody(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertItemRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevertItem(ctx context.Context, id uint32, body RevertItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertItemRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListItemSiblings(ctx context.Context, id uint32, params *ListItemSiblingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListItemSiblingsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewListItemRequest generates requests for ListItem
 func NewListItemRequest(server string, params *ListItemParams) (*http.Request, error) {
 	var err error
@@ -425,7 +1267,7 @@ func NewListItemRequest(server string, params *ListItemParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree")
+	operationPath := fmt.Sprintf("/")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -486,9 +1328,9 @@ func NewListItemRequest(server string, params *ListItemParams) (*http.Request, e
 
 		}
 
-		if params.Trashed != nil {
+		if params.Pagination != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pagination", runtime.ParamLocationQuery, *params.Pagination); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -502,38 +1344,118 @@ func NewListItemRequest(server string, params *ListItemParams) (*http.Request, e
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.Cursor != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewCreateItemRequest calls the generic CreateItem builder with application/json body
-func NewCreateItemRequest(server string, body CreateItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateItemRequestWithBody(server, "application/json", bodyReader)
-}
+		if params.AsOf != nil {
 
-// NewCreateItemRequestWithBody generates requests for CreateItem with any type of body
-func NewCreateItemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "as_of", runtime.ParamLocationQuery, *params.AsOf); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Trashed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateItemRequest calls the generic CreateItem builder with application/json body
+func NewCreateItemRequest(server string, body CreateItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateItemRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateItemRequestWithBody generates requests for CreateItem with any type of body
+func NewCreateItemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	operationPath := fmt.Sprintf("/simple-tree")
+	operationPath := fmt.Sprintf("/")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -553,23 +1475,58 @@ func NewCreateItemRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewDeleteItemRequest generates requests for DeleteItem
-func NewDeleteItemRequest(server string, id uint32, params *DeleteItemParams) (*http.Request, error) {
+// NewStreamItemEventsRequest generates requests for StreamItemEvents
+func NewStreamItemEventsRequest(server string, params *StreamItemEventsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	operationPath := fmt.Sprintf("/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListWebhookRequest generates requests for ListWebhook
+func NewListWebhookRequest(server string, params *ListWebhookParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree/%s", pathParam0)
+	operationPath := fmt.Sprintf("/webhooks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -582,9 +1539,25 @@ func NewDeleteItemRequest(server string, id uint32, params *DeleteItemParams) (*
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Trashed != nil {
+		if params.Page != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -601,7 +1574,7 @@ func NewDeleteItemRequest(server string, id uint32, params *DeleteItemParams) (*
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -609,8 +1582,48 @@ func NewDeleteItemRequest(server string, id uint32, params *DeleteItemParams) (*
 	return req, nil
 }
 
-// NewReadItemRequest generates requests for ReadItem
-func NewReadItemRequest(server string, id uint32, params *ReadItemParams) (*http.Request, error) {
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, id uint32) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -625,7 +1638,7 @@ func NewReadItemRequest(server string, id uint32, params *ReadItemParams) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree/%s", pathParam0)
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -635,26 +1648,38 @@ func NewReadItemRequest(server string, id uint32, params *ReadItemParams) (*http
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Trashed != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewReadWebhookRequest generates requests for ReadWebhook
+func NewReadWebhookRequest(server string, id uint32) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -665,19 +1690,19 @@ func NewReadItemRequest(server string, id uint32, params *ReadItemParams) (*http
 	return req, nil
 }
 
-// NewUpdateItemRequest calls the generic UpdateItem builder with application/json body
-func NewUpdateItemRequest(server string, id uint32, body UpdateItemJSONRequestBody) (*http.Request, error) {
+// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
+func NewUpdateWebhookRequest(server string, id uint32, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateItemRequestWithBody(server, id, "application/json", bodyReader)
+	return NewUpdateWebhookRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewUpdateItemRequestWithBody generates requests for UpdateItem with any type of body
-func NewUpdateItemRequestWithBody(server string, id uint32, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
+func NewUpdateWebhookRequestWithBody(server string, id uint32, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -692,7 +1717,7 @@ func NewUpdateItemRequestWithBody(server string, id uint32, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree/%s", pathParam0)
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -712,8 +1737,8 @@ func NewUpdateItemRequestWithBody(server string, id uint32, contentType string,
 	return req, nil
 }
 
-// NewListItemChildrenRequest generates requests for ListItemChildren
-func NewListItemChildrenRequest(server string, id uint32, params *ListItemChildrenParams) (*http.Request, error) {
+// NewListWebhookDeadLetterRequest generates requests for ListWebhookDeadLetter
+func NewListWebhookDeadLetterRequest(server string, id uint32, params *ListWebhookDeadLetterParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -728,7 +1753,7 @@ func NewListItemChildrenRequest(server string, id uint32, params *ListItemChildr
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree/%s/children", pathParam0)
+	operationPath := fmt.Sprintf("/webhooks/%s/dead-letters", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -773,38 +1798,6 @@ func NewListItemChildrenRequest(server string, id uint32, params *ListItemChildr
 
 		}
 
-		if params.Name != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Recurse != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recurse", runtime.ParamLocationQuery, *params.Recurse); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -816,8 +1809,8 @@ func NewListItemChildrenRequest(server string, id uint32, params *ListItemChildr
 	return req, nil
 }
 
-// NewReadItemParentRequest generates requests for ReadItemParent
-func NewReadItemParentRequest(server string, id uint32) (*http.Request, error) {
+// NewDeleteItemRequest generates requests for DeleteItem
+func NewDeleteItemRequest(server string, id uint32, params *DeleteItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -832,7 +1825,7 @@ func NewReadItemParentRequest(server string, id uint32) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree/%s/parent", pathParam0)
+	operationPath := fmt.Sprintf("/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -842,16 +1835,38 @@ func NewReadItemParentRequest(server string, id uint32) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Trashed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewRestoreItemRequest generates requests for RestoreItem
-func NewRestoreItemRequest(server string, id uint32) (*http.Request, error) {
+// NewReadItemRequest generates requests for ReadItem
+func NewReadItemRequest(server string, id uint32, params *ReadItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -866,7 +1881,7 @@ func NewRestoreItemRequest(server string, id uint32) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/simple-tree/%s/restore", pathParam0)
+	operationPath := fmt.Sprintf("/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -876,472 +1891,4841 @@ func NewRestoreItemRequest(server string, id uint32) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.AsOf != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "as_of", runtime.ParamLocationQuery, *params.AsOf); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.Fields != nil {
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
 		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListItemWithResponse request
-	ListItemWithResponse(ctx context.Context, params *ListItemParams, reqEditors ...RequestEditorFn) (*ListItemResponse, error)
+		if params.Expand != nil {
 
-	// CreateItemWithBodyWithResponse request with any body
-	CreateItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateItemResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	CreateItemWithResponse(ctx context.Context, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateItemResponse, error)
+		}
 
-	// DeleteItemWithResponse request
-	DeleteItemWithResponse(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*DeleteItemResponse, error)
+		if params.Trashed != nil {
 
-	// ReadItemWithResponse request
-	ReadItemWithResponse(ctx context.Context, id uint32, params *ReadItemParams, reqEditors ...RequestEditorFn) (*ReadItemResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UpdateItemWithBodyWithResponse request with any body
-	UpdateItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error)
+		}
 
-	UpdateItemWithResponse(ctx context.Context, id uint32, body UpdateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// ListItemChildrenWithResponse request
-	ListItemChildrenWithResponse(ctx context.Context, id uint32, params *ListItemChildrenParams, reqEditors ...RequestEditorFn) (*ListItemChildrenResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ReadItemParentWithResponse request
-	ReadItemParentWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*ReadItemParentResponse, error)
+	return req, nil
+}
 
-	// RestoreItemWithResponse request
-	RestoreItemWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*RestoreItemResponse, error)
+// NewUpdateItemRequest calls the generic UpdateItem builder with application/json body
+func NewUpdateItemRequest(server string, id uint32, body UpdateItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateItemRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-type ListItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// CurrentPage Page number (1-based)
-		CurrentPage int `json:"current_page"`
+// NewUpdateItemRequestWithBody generates requests for UpdateItem with any type of body
+func NewUpdateItemRequestWithBody(server string, id uint32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		// Data List of items
-		Data []ItemList `json:"data"`
+	var pathParam0 string
 
-		// FirstPageUrl URL to the first page
-		FirstPageUrl string `json:"first_page_url"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-		// From Index (1-based) of the first item in the current page
-		From int `json:"from"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		// LastPage Last page number
-		LastPage int `json:"last_page"`
+	operationPath := fmt.Sprintf("/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		// LastPageUrl URL to the last page
-		LastPageUrl string `json:"last_page_url"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		// NextPageUrl URL to the next page
-		NextPageUrl string `json:"next_page_url"`
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		// Path Base path of the request
-		Path string `json:"path"`
+	req.Header.Add("Content-Type", contentType)
 
-		// PerPage Number of items per page
-		PerPage int `json:"per_page"`
+	return req, nil
+}
 
-		// PrevPageUrl URL to the previous page
-		PrevPageUrl string `json:"prev_page_url"`
+// NewListItemChildrenRequest generates requests for ListItemChildren
+func NewListItemChildrenRequest(server string, id uint32, params *ListItemChildrenParams) (*http.Request, error) {
+	var err error
 
-		// To Index (1-based) of the last item in the current page
-		To int `json:"to"`
+	var pathParam0 string
 
-		// Total Total number of items
-		Total int `json:"total"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *N400
-	JSON404 *N404
-	JSON409 *N409
-	JSON500 *N500
-}
 
-// Status returns HTTPResponse.Status
-func (r ListItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/%s/children", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type CreateItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemCreate
-	JSON400      *N400
-	JSON409      *N409
-	JSON500      *N500
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type DeleteItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
+		if params.Page != nil {
 
-// Status returns HTTPResponse.Status
-func (r DeleteItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Recurse != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recurse", runtime.ParamLocationQuery, *params.Recurse); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Pagination != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pagination", runtime.ParamLocationQuery, *params.Pagination); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AsOf != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "as_of", runtime.ParamLocationQuery, *params.AsOf); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Trashed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCopyItemRequest calls the generic CopyItem builder with application/json body
+func NewCopyItemRequest(server string, id uint32, body CopyItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCopyItemRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-type ReadItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemRead
-	JSON400      *N400
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
+// NewCopyItemRequestWithBody generates requests for CopyItem with any type of body
+func NewCopyItemRequestWithBody(server string, id uint32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/copy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ReadItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListItemGrantRequest generates requests for ListItemGrant
+func NewListItemGrantRequest(server string, id uint32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/grants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReadItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewDeleteItemGrantRequest generates requests for DeleteItemGrant
+func NewDeleteItemGrantRequest(server string, id uint32, subject string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "subject", runtime.ParamLocationPath, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/grants/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetItemGrantRequest calls the generic SetItemGrant builder with application/json body
+func NewSetItemGrantRequest(server string, id uint32, subject string, body SetItemGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetItemGrantRequestWithBody(server, id, subject, "application/json", bodyReader)
+}
+
+// NewSetItemGrantRequestWithBody generates requests for SetItemGrant with any type of body
+func NewSetItemGrantRequestWithBody(server string, id uint32, subject string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "subject", runtime.ParamLocationPath, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/grants/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListItemHistoryRequest generates requests for ListItemHistory
+func NewListItemHistoryRequest(server string, id uint32, params *ListItemHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadItemParentRequest generates requests for ReadItemParent
+func NewReadItemParentRequest(server string, id uint32, params *ReadItemParentParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/parent", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Trashed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadItemRelationRequest generates requests for ReadItemRelation
+func NewReadItemRelationRequest(server string, id uint32, other uint32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "other", runtime.ParamLocationPath, other)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/relation/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreItemRequest generates requests for RestoreItem
+func NewRestoreItemRequest(server string, id uint32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevertItemRequest calls the generic RevertItem builder with application/json body
+func NewRevertItemRequest(server string, id uint32, body RevertItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRevertItemRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRevertItemRequestWithBody generates requests for RevertItem with any type of body
+func NewRevertItemRequestWithBody(server string, id uint32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/revert", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListItemSiblingsRequest generates requests for ListItemSiblings
+func NewListItemSiblingsRequest(server string, id uint32, params *ListItemSiblingsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/siblings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeSelf != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_self", runtime.ParamLocationQuery, *params.ExcludeSelf); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Pagination != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pagination", runtime.ParamLocationQuery, *params.Pagination); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Trashed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trashed", runtime.ParamLocationQuery, *params.Trashed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListItemWithResponse request
+	ListItemWithResponse(ctx context.Context, params *ListItemParams, reqEditors ...RequestEditorFn) (*ListItemResponse, error)
+
+	// CreateItemWithBodyWithResponse request with any body
+	CreateItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateItemResponse, error)
+
+	CreateItemWithResponse(ctx context.Context, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateItemResponse, error)
+
+	// StreamItemEventsWithResponse request
+	StreamItemEventsWithResponse(ctx context.Context, params *StreamItemEventsParams, reqEditors ...RequestEditorFn) (*StreamItemEventsResponse, error)
+
+	// ListWebhookWithResponse request
+	ListWebhookWithResponse(ctx context.Context, params *ListWebhookParams, reqEditors ...RequestEditorFn) (*ListWebhookResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// ReadWebhookWithResponse request
+	ReadWebhookWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*ReadWebhookResponse, error)
+
+	// UpdateWebhookWithBodyWithResponse request with any body
+	UpdateWebhookWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	UpdateWebhookWithResponse(ctx context.Context, id uint32, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	// ListWebhookDeadLetterWithResponse request
+	ListWebhookDeadLetterWithResponse(ctx context.Context, id uint32, params *ListWebhookDeadLetterParams, reqEditors ...RequestEditorFn) (*ListWebhookDeadLetterResponse, error)
+
+	// DeleteItemWithResponse request
+	DeleteItemWithResponse(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*DeleteItemResponse, error)
+
+	// ReadItemWithResponse request
+	ReadItemWithResponse(ctx context.Context, id uint32, params *ReadItemParams, reqEditors ...RequestEditorFn) (*ReadItemResponse, error)
+
+	// UpdateItemWithBodyWithResponse request with any body
+	UpdateItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error)
+
+	UpdateItemWithResponse(ctx context.Context, id uint32, body UpdateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error)
+
+	// ListItemChildrenWithResponse request
+	ListItemChildrenWithResponse(ctx context.Context, id uint32, params *ListItemChildrenParams, reqEditors ...RequestEditorFn) (*ListItemChildrenResponse, error)
+
+	// CopyItemWithBodyWithResponse request with any body
+	CopyItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyItemResponse, error)
+
+	CopyItemWithResponse(ctx context.Context, id uint32, body CopyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyItemResponse, error)
+
+	// ListItemGrantWithResponse request
+	ListItemGrantWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*ListItemGrantResponse, error)
+
+	// DeleteItemGrantWithResponse request
+	DeleteItemGrantWithResponse(ctx context.Context, id uint32, subject string, reqEditors ...RequestEditorFn) (*DeleteItemGrantResponse, error)
+
+	// SetItemGrantWithBodyWithResponse request with any body
+	SetItemGrantWithBodyWithResponse(ctx context.Context, id uint32, subject string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetItemGrantResponse, error)
+
+	SetItemGrantWithResponse(ctx context.Context, id uint32, subject string, body SetItemGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*SetItemGrantResponse, error)
+
+	// ListItemHistoryWithResponse request
+	ListItemHistoryWithResponse(ctx context.Context, id uint32, params *ListItemHistoryParams, reqEditors ...RequestEditorFn) (*ListItemHistoryResponse, error)
+
+	// ReadItemParentWithResponse request
+	ReadItemParentWithResponse(ctx context.Context, id uint32, params *ReadItemParentParams, reqEditors ...RequestEditorFn) (*ReadItemParentResponse, error)
+
+	// ReadItemRelationWithResponse request
+	ReadItemRelationWithResponse(ctx context.Context, id uint32, other uint32, reqEditors ...RequestEditorFn) (*ReadItemRelationResponse, error)
+
+	// RestoreItemWithResponse request
+	RestoreItemWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*RestoreItemResponse, error)
+
+	// RevertItemWithBodyWithResponse request with any body
+	RevertItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevertItemResponse, error)
+
+	RevertItemWithResponse(ctx context.Context, id uint32, body RevertItemJSONRequestBody, reqEditors ...RequestEditorFn) (*RevertItemResponse, error)
+
+	// ListItemSiblingsWithResponse request
+	ListItemSiblingsWithResponse(ctx context.Context, id uint32, params *ListItemSiblingsParams, reqEditors ...RequestEditorFn) (*ListItemSiblingsResponse, error)
+}
+
+type ListItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentPage Page number (1-based), 0 in cursor pagination
+		CurrentPage int `json:"current_page"`
+
+		// Data List of items
+		Data []ItemList `json:"data"`
+
+		// FirstPageUrl URL to the first page
+		FirstPageUrl string `json:"first_page_url"`
+
+		// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+		From int `json:"from"`
+
+		// LastPage Last page number, 0 in cursor pagination
+		LastPage int `json:"last_page"`
+
+		// LastPageUrl URL to the last page, empty in cursor pagination
+		LastPageUrl string `json:"last_page_url"`
+
+		// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+
+		// NextPageUrl URL to the next page
+		NextPageUrl string `json:"next_page_url"`
+
+		// Path Base path of the request
+		Path string `json:"path"`
+
+		// PerPage Number of items per page
+		PerPage int `json:"per_page"`
+
+		// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+		PrevCursor *string `json:"prev_cursor,omitempty"`
+
+		// PrevPageUrl URL to the previous page
+		PrevPageUrl string `json:"prev_page_url"`
+
+		// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+		To int `json:"to"`
+
+		// Total Total number of items, 0 in cursor pagination
+		Total int `json:"total"`
+	}
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ItemCreate
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamItemEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamItemEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamItemEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentPage Page number (1-based)
+		CurrentPage int `json:"current_page"`
+
+		// Data List of items
+		Data []WebhookList `json:"data"`
+
+		// FirstPageUrl URL to the first page
+		FirstPageUrl string `json:"first_page_url"`
+
+		// From Index (1-based) of the first item in the current page
+		From int `json:"from"`
+
+		// LastPage Last page number
+		LastPage int `json:"last_page"`
+
+		// LastPageUrl URL to the last page
+		LastPageUrl string `json:"last_page_url"`
+
+		// NextPageUrl URL to the next page
+		NextPageUrl string `json:"next_page_url"`
+
+		// Path Base path of the request
+		Path string `json:"path"`
+
+		// PerPage Number of items per page
+		PerPage int `json:"per_page"`
+
+		// PrevPageUrl URL to the previous page
+		PrevPageUrl string `json:"prev_page_url"`
+
+		// To Index (1-based) of the last item in the current page
+		To int `json:"to"`
+
+		// Total Total number of items
+		Total int `json:"total"`
+	}
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookCreate
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookRead
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookUpdate
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeadLetterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentPage Page number (1-based)
+		CurrentPage int `json:"current_page"`
+
+		// Data List of items
+		Data []WebhookDelivery `json:"data"`
+
+		// FirstPageUrl URL to the first page
+		FirstPageUrl string `json:"first_page_url"`
+
+		// From Index (1-based) of the first item in the current page
+		From int `json:"from"`
+
+		// LastPage Last page number
+		LastPage int `json:"last_page"`
+
+		// LastPageUrl URL to the last page
+		LastPageUrl string `json:"last_page_url"`
+
+		// NextPageUrl URL to the next page
+		NextPageUrl string `json:"next_page_url"`
+
+		// Path Base path of the request
+		Path string `json:"path"`
+
+		// PerPage Number of items per page
+		PerPage int `json:"per_page"`
+
+		// PrevPageUrl URL to the previous page
+		PrevPageUrl string `json:"prev_page_url"`
+
+		// To Index (1-based) of the last item in the current page
+		To int `json:"to"`
+
+		// Total Total number of items
+		Total int `json:"total"`
+	}
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeadLetterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeadLetterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ItemRead
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ItemUpdate
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListItemChildrenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentPage Page number (1-based), 0 in cursor pagination
+		CurrentPage int `json:"current_page"`
+
+		// Data List of items
+		Data []ItemList `json:"data"`
+
+		// FirstPageUrl URL to the first page
+		FirstPageUrl string `json:"first_page_url"`
+
+		// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+		From int `json:"from"`
+
+		// LastPage Last page number, 0 in cursor pagination
+		LastPage int `json:"last_page"`
+
+		// LastPageUrl URL to the last page, empty in cursor pagination
+		LastPageUrl string `json:"last_page_url"`
+
+		// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+
+		// NextPageUrl URL to the next page
+		NextPageUrl string `json:"next_page_url"`
+
+		// Path Base path of the request
+		Path string `json:"path"`
+
+		// PerPage Number of items per page
+		PerPage int `json:"per_page"`
+
+		// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+		PrevCursor *string `json:"prev_cursor,omitempty"`
+
+		// PrevPageUrl URL to the previous page
+		PrevPageUrl string `json:"prev_page_url"`
+
+		// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+		To int `json:"to"`
+
+		// Total Total number of items, 0 in cursor pagination
+		Total int `json:"total"`
+	}
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemChildrenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemChildrenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CopyItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *ItemCopy
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r CopyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CopyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListItemGrantResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Grant
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteItemGrantResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteItemGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteItemGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetItemGrantResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Grant
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r SetItemGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetItemGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListItemHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentPage Page number (1-based)
+		CurrentPage int `json:"current_page"`
+
+		// Data List of items
+		Data []ItemHistory `json:"data"`
+
+		// FirstPageUrl URL to the first page
+		FirstPageUrl string `json:"first_page_url"`
+
+		// From Index (1-based) of the first item in the current page
+		From int `json:"from"`
+
+		// LastPage Last page number
+		LastPage int `json:"last_page"`
+
+		// LastPageUrl URL to the last page
+		LastPageUrl string `json:"last_page_url"`
+
+		// NextPageUrl URL to the next page
+		NextPageUrl string `json:"next_page_url"`
+
+		// Path Base path of the request
+		Path string `json:"path"`
+
+		// PerPage Number of items per page
+		PerPage int `json:"per_page"`
+
+		// PrevPageUrl URL to the previous page
+		PrevPageUrl string `json:"prev_page_url"`
+
+		// To Index (1-based) of the last item in the current page
+		To int `json:"to"`
+
+		// Total Total number of items
+		Total int `json:"total"`
+	}
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadItemParentResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ItemParentRead
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadItemParentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadItemParentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadItemRelationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ItemRelation
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadItemRelationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadItemRelationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ItemUpdate
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON413                   *N413ApplicationJSON
+	ApplicationproblemJSON413 *N413ApplicationProblemPlusJSON
+	JSON422                   *N422ApplicationJSON
+	ApplicationproblemJSON422 *N422ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListItemSiblingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// CurrentPage Page number (1-based), 0 in cursor pagination
+		CurrentPage int `json:"current_page"`
+
+		// Data List of items
+		Data []ItemList `json:"data"`
+
+		// FirstPageUrl URL to the first page
+		FirstPageUrl string `json:"first_page_url"`
+
+		// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+		From int `json:"from"`
+
+		// LastPage Last page number, 0 in cursor pagination
+		LastPage int `json:"last_page"`
+
+		// LastPageUrl URL to the last page, empty in cursor pagination
+		LastPageUrl string `json:"last_page_url"`
+
+		// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+		NextCursor *string `json:"next_cursor,omitempty"`
+
+		// NextPageUrl URL to the next page
+		NextPageUrl string `json:"next_page_url"`
+
+		// Path Base path of the request
+		Path string `json:"path"`
+
+		// PerPage Number of items per page
+		PerPage int `json:"per_page"`
+
+		// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+		PrevCursor *string `json:"prev_cursor,omitempty"`
+
+		// PrevPageUrl URL to the previous page
+		PrevPageUrl string `json:"prev_page_url"`
+
+		// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+		To int `json:"to"`
+
+		// Total Total number of items, 0 in cursor pagination
+		Total int `json:"total"`
+	}
+	JSON400                   *N400ApplicationJSON
+	ApplicationproblemJSON400 *N400ApplicationProblemPlusJSON
+	JSON401                   *N401ApplicationJSON
+	ApplicationproblemJSON401 *N401ApplicationProblemPlusJSON
+	JSON403                   *N403ApplicationJSON
+	ApplicationproblemJSON403 *N403ApplicationProblemPlusJSON
+	JSON404                   *N404ApplicationJSON
+	ApplicationproblemJSON404 *N404ApplicationProblemPlusJSON
+	JSON409                   *N409ApplicationJSON
+	ApplicationproblemJSON409 *N409ApplicationProblemPlusJSON
+	JSON429                   *N429ApplicationJSON
+	ApplicationproblemJSON429 *N429ApplicationProblemPlusJSON
+	JSON500                   *N500ApplicationJSON
+	ApplicationproblemJSON500 *N500ApplicationProblemPlusJSON
+}
+
+// Status returns HTTPResponse.Status
+func (r ListItemSiblingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListItemSiblingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListItemWithResponse request returning *ListItemResponse
+func (c *ClientWithResponses) ListItemWithResponse(ctx context.Context, params *ListItemParams, reqEditors ...RequestEditorFn) (*ListItemResponse, error) {
+	rsp, err := c.ListItem(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListItemResponse(rsp)
+}
+
+// CreateItemWithBodyWithResponse request with arbitrary body returning *CreateItemResponse
+func (c *ClientWithResponses) CreateItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateItemResponse, error) {
+	rsp, err := c.CreateItemWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateItemWithResponse(ctx context.Context, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateItemResponse, error) {
+	rsp, err := c.CreateItem(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateItemResponse(rsp)
+}
+
+// StreamItemEventsWithResponse request returning *StreamItemEventsResponse
+func (c *ClientWithResponses) StreamItemEventsWithResponse(ctx context.Context, params *StreamItemEventsParams, reqEditors ...RequestEditorFn) (*StreamItemEventsResponse, error) {
+	rsp, err := c.StreamItemEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamItemEventsResponse(rsp)
+}
+
+// ListWebhookWithResponse request returning *ListWebhookResponse
+func (c *ClientWithResponses) ListWebhookWithResponse(ctx context.Context, params *ListWebhookParams, reqEditors ...RequestEditorFn) (*ListWebhookResponse, error) {
+	rsp, err := c.ListWebhook(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// ReadWebhookWithResponse request returning *ReadWebhookResponse
+func (c *ClientWithResponses) ReadWebhookWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*ReadWebhookResponse, error) {
+	rsp, err := c.ReadWebhook(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadWebhookResponse(rsp)
+}
+
+// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
+func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhookWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, id uint32, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhook(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+// ListWebhookDeadLetterWithResponse request returning *ListWebhookDeadLetterResponse
+func (c *ClientWithResponses) ListWebhookDeadLetterWithResponse(ctx context.Context, id uint32, params *ListWebhookDeadLetterParams, reqEditors ...RequestEditorFn) (*ListWebhookDeadLetterResponse, error) {
+	rsp, err := c.ListWebhookDeadLetter(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeadLetterResponse(rsp)
+}
+
+// DeleteItemWithResponse request returning *DeleteItemResponse
+func (c *ClientWithResponses) DeleteItemWithResponse(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*DeleteItemResponse, error) {
+	rsp, err := c.DeleteItem(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteItemResponse(rsp)
+}
+
+// ReadItemWithResponse request returning *ReadItemResponse
+func (c *ClientWithResponses) ReadItemWithResponse(ctx context.Context, id uint32, params *ReadItemParams, reqEditors ...RequestEditorFn) (*ReadItemResponse, error) {
+	rsp, err := c.ReadItem(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadItemResponse(rsp)
+}
+
+// UpdateItemWithBodyWithResponse request with arbitrary body returning *UpdateItemResponse
+func (c *ClientWithResponses) UpdateItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error) {
+	rsp, err := c.UpdateItemWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateItemWithResponse(ctx context.Context, id uint32, body UpdateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error) {
+	rsp, err := c.UpdateItem(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateItemResponse(rsp)
+}
+
+// ListItemChildrenWithResponse request returning *ListItemChildrenResponse
+func (c *ClientWithResponses) ListItemChildrenWithResponse(ctx context.Context, id uint32, params *ListItemChildrenParams, reqEditors ...RequestEditorFn) (*ListItemChildrenResponse, error) {
+	rsp, err := c.ListItemChildren(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListItemChildrenResponse(rsp)
+}
+
+// CopyItemWithBodyWithResponse request with arbitrary body returning *CopyItemResponse
+func (c *ClientWithResponses) CopyItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CopyItemResponse, error) {
+	rsp, err := c.CopyItemWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) CopyItemWithResponse(ctx context.Context, id uint32, body CopyItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CopyItemResponse, error) {
+	rsp, err := c.CopyItem(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCopyItemResponse(rsp)
+}
+
+// ListItemGrantWithResponse request returning *ListItemGrantResponse
+func (c *ClientWithResponses) ListItemGrantWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*ListItemGrantResponse, error) {
+	rsp, err := c.ListItemGrant(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListItemGrantResponse(rsp)
+}
+
+// DeleteItemGrantWithResponse request returning *DeleteItemGrantResponse
+func (c *ClientWithResponses) DeleteItemGrantWithResponse(ctx context.Context, id uint32, subject string, reqEditors ...RequestEditorFn) (*DeleteItemGrantResponse, error) {
+	rsp, err := c.DeleteItemGrant(ctx, id, subject, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteItemGrantResponse(rsp)
+}
+
+// SetItemGrantWithBodyWithResponse request with arbitrary body returning *SetItemGrantResponse
+func (c *ClientWithResponses) SetItemGrantWithBodyWithResponse(ctx context.Context, id uint32, subject string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetItemGrantResponse, error) {
+	rsp, err := c.SetItemGrantWithBody(ctx, id, subject, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetItemGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetItemGrantWithResponse(ctx context.Context, id uint32, subject string, body SetItemGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*SetItemGrantResponse, error) {
+	rsp, err := c.SetItemGrant(ctx, id, subject, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetItemGrantResponse(rsp)
+}
+
+// ListItemHistoryWithResponse request returning *ListItemHistoryResponse
+func (c *ClientWithResponses) ListItemHistoryWithResponse(ctx context.Context, id uint32, params *ListItemHistoryParams, reqEditors ...RequestEditorFn) (*ListItemHistoryResponse, error) {
+	rsp, err := c.ListItemHistory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListItemHistoryResponse(rsp)
+}
+
+// ReadItemParentWithResponse request returning *ReadItemParentResponse
+func (c *ClientWithResponses) ReadItemParentWithResponse(ctx context.Context, id uint32, params *ReadItemParentParams, reqEditors ...RequestEditorFn) (*ReadItemParentResponse, error) {
+	rsp, err := c.ReadItemParent(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadItemParentResponse(rsp)
+}
+
+// ReadItemRelationWithResponse request returning *ReadItemRelationResponse
+func (c *ClientWithResponses) ReadItemRelationWithResponse(ctx context.Context, id uint32, other uint32, reqEditors ...RequestEditorFn) (*ReadItemRelationResponse, error) {
+	rsp, err := c.ReadItemRelation(ctx, id, other, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadItemRelationResponse(rsp)
+}
+
+// RestoreItemWithResponse request returning *RestoreItemResponse
+func (c *ClientWithResponses) RestoreItemWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*RestoreItemResponse, error) {
+	rsp, err := c.RestoreItem(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreItemResponse(rsp)
+}
+
+// RevertItemWithBodyWithResponse request with arbitrary body returning *RevertItemResponse
+func (c *ClientWithResponses) RevertItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevertItemResponse, error) {
+	rsp, err := c.RevertItemWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) RevertItemWithResponse(ctx context.Context, id uint32, body RevertItemJSONRequestBody, reqEditors ...RequestEditorFn) (*RevertItemResponse, error) {
+	rsp, err := c.RevertItem(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertItemResponse(rsp)
+}
+
+// ListItemSiblingsWithResponse request returning *ListItemSiblingsResponse
+func (c *ClientWithResponses) ListItemSiblingsWithResponse(ctx context.Context, id uint32, params *ListItemSiblingsParams, reqEditors ...RequestEditorFn) (*ListItemSiblingsResponse, error) {
+	rsp, err := c.ListItemSiblings(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListItemSiblingsResponse(rsp)
+}
+
+// ParseListItemResponse parses an HTTP response from a ListItemWithResponse call
+func ParseListItemResponse(rsp *http.Response) (*ListItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentPage Page number (1-based), 0 in cursor pagination
+			CurrentPage int `json:"current_page"`
+
+			// Data List of items
+			Data []ItemList `json:"data"`
+
+			// FirstPageUrl URL to the first page
+			FirstPageUrl string `json:"first_page_url"`
+
+			// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+			From int `json:"from"`
+
+			// LastPage Last page number, 0 in cursor pagination
+			LastPage int `json:"last_page"`
+
+			// LastPageUrl URL to the last page, empty in cursor pagination
+			LastPageUrl string `json:"last_page_url"`
+
+			// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+			NextCursor *string `json:"next_cursor,omitempty"`
+
+			// NextPageUrl URL to the next page
+			NextPageUrl string `json:"next_page_url"`
+
+			// Path Base path of the request
+			Path string `json:"path"`
+
+			// PerPage Number of items per page
+			PerPage int `json:"per_page"`
+
+			// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+			PrevCursor *string `json:"prev_cursor,omitempty"`
+
+			// PrevPageUrl URL to the previous page
+			PrevPageUrl string `json:"prev_page_url"`
+
+			// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+			To int `json:"to"`
+
+			// Total Total number of items, 0 in cursor pagination
+			Total int `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateItemResponse parses an HTTP response from a CreateItemWithResponse call
+func ParseCreateItemResponse(rsp *http.Response) (*CreateItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ItemCreate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamItemEventsResponse parses an HTTP response from a StreamItemEventsWithResponse call
+func ParseStreamItemEventsResponse(rsp *http.Response) (*StreamItemEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamItemEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookResponse parses an HTTP response from a ListWebhookWithResponse call
+func ParseListWebhookResponse(rsp *http.Response) (*ListWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentPage Page number (1-based)
+			CurrentPage int `json:"current_page"`
+
+			// Data List of items
+			Data []WebhookList `json:"data"`
+
+			// FirstPageUrl URL to the first page
+			FirstPageUrl string `json:"first_page_url"`
+
+			// From Index (1-based) of the first item in the current page
+			From int `json:"from"`
+
+			// LastPage Last page number
+			LastPage int `json:"last_page"`
+
+			// LastPageUrl URL to the last page
+			LastPageUrl string `json:"last_page_url"`
+
+			// NextPageUrl URL to the next page
+			NextPageUrl string `json:"next_page_url"`
+
+			// Path Base path of the request
+			Path string `json:"path"`
+
+			// PerPage Number of items per page
+			PerPage int `json:"per_page"`
+
+			// PrevPageUrl URL to the previous page
+			PrevPageUrl string `json:"prev_page_url"`
+
+			// To Index (1-based) of the last item in the current page
+			To int `json:"to"`
+
+			// Total Total number of items
+			Total int `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookCreate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadWebhookResponse parses an HTTP response from a ReadWebhookWithResponse call
+func ParseReadWebhookResponse(rsp *http.Response) (*ReadWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookRead
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookResponse parses an HTTP response from a UpdateWebhookWithResponse call
+func ParseUpdateWebhookResponse(rsp *http.Response) (*UpdateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookUpdate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeadLetterResponse parses an HTTP response from a ListWebhookDeadLetterWithResponse call
+func ParseListWebhookDeadLetterResponse(rsp *http.Response) (*ListWebhookDeadLetterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeadLetterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentPage Page number (1-based)
+			CurrentPage int `json:"current_page"`
+
+			// Data List of items
+			Data []WebhookDelivery `json:"data"`
+
+			// FirstPageUrl URL to the first page
+			FirstPageUrl string `json:"first_page_url"`
+
+			// From Index (1-based) of the first item in the current page
+			From int `json:"from"`
+
+			// LastPage Last page number
+			LastPage int `json:"last_page"`
+
+			// LastPageUrl URL to the last page
+			LastPageUrl string `json:"last_page_url"`
+
+			// NextPageUrl URL to the next page
+			NextPageUrl string `json:"next_page_url"`
+
+			// Path Base path of the request
+			Path string `json:"path"`
+
+			// PerPage Number of items per page
+			PerPage int `json:"per_page"`
+
+			// PrevPageUrl URL to the previous page
+			PrevPageUrl string `json:"prev_page_url"`
+
+			// To Index (1-based) of the last item in the current page
+			To int `json:"to"`
+
+			// Total Total number of items
+			Total int `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteItemResponse parses an HTTP response from a DeleteItemWithResponse call
+func ParseDeleteItemResponse(rsp *http.Response) (*DeleteItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadItemResponse parses an HTTP response from a ReadItemWithResponse call
+func ParseReadItemResponse(rsp *http.Response) (*ReadItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemRead
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateItemResponse parses an HTTP response from a UpdateItemWithResponse call
+func ParseUpdateItemResponse(rsp *http.Response) (*UpdateItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemUpdate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListItemChildrenResponse parses an HTTP response from a ListItemChildrenWithResponse call
+func ParseListItemChildrenResponse(rsp *http.Response) (*ListItemChildrenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListItemChildrenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentPage Page number (1-based), 0 in cursor pagination
+			CurrentPage int `json:"current_page"`
+
+			// Data List of items
+			Data []ItemList `json:"data"`
+
+			// FirstPageUrl URL to the first page
+			FirstPageUrl string `json:"first_page_url"`
+
+			// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+			From int `json:"from"`
+
+			// LastPage Last page number, 0 in cursor pagination
+			LastPage int `json:"last_page"`
+
+			// LastPageUrl URL to the last page, empty in cursor pagination
+			LastPageUrl string `json:"last_page_url"`
+
+			// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+			NextCursor *string `json:"next_cursor,omitempty"`
+
+			// NextPageUrl URL to the next page
+			NextPageUrl string `json:"next_page_url"`
+
+			// Path Base path of the request
+			Path string `json:"path"`
+
+			// PerPage Number of items per page
+			PerPage int `json:"per_page"`
+
+			// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+			PrevCursor *string `json:"prev_cursor,omitempty"`
+
+			// PrevPageUrl URL to the previous page
+			PrevPageUrl string `json:"prev_page_url"`
+
+			// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+			To int `json:"to"`
+
+			// Total Total number of items, 0 in cursor pagination
+			Total int `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCopyItemResponse parses an HTTP response from a CopyItemWithResponse call
+func ParseCopyItemResponse(rsp *http.Response) (*CopyItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CopyItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ItemCopy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListItemGrantResponse parses an HTTP response from a ListItemGrantWithResponse call
+func ParseListItemGrantResponse(rsp *http.Response) (*ListItemGrantResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListItemGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Grant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type UpdateItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemUpdate
-	JSON400      *N400
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteItemGrantResponse parses an HTTP response from a DeleteItemGrantWithResponse call
+func ParseDeleteItemGrantResponse(rsp *http.Response) (*DeleteItemGrantResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteItemGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListItemChildrenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		// CurrentPage Page number (1-based)
-		CurrentPage int `json:"current_page"`
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-		// Data List of items
-		Data []ItemList `json:"data"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-		// FirstPageUrl URL to the first page
-		FirstPageUrl string `json:"first_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-		// From Index (1-based) of the first item in the current page
-		From int `json:"from"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-		// LastPage Last page number
-		LastPage int `json:"last_page"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
-		// LastPageUrl URL to the last page
-		LastPageUrl string `json:"last_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-		// NextPageUrl URL to the next page
-		NextPageUrl string `json:"next_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-		// Path Base path of the request
-		Path string `json:"path"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-		// PerPage Number of items per page
-		PerPage int `json:"per_page"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
 
-		// PrevPageUrl URL to the previous page
-		PrevPageUrl string `json:"prev_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
-		// To Index (1-based) of the last item in the current page
-		To int `json:"to"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-		// Total Total number of items
-		Total int `json:"total"`
 	}
-	JSON400 *N400
-	JSON404 *N404
-	JSON409 *N409
-	JSON500 *N500
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListItemChildrenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetItemGrantResponse parses an HTTP response from a SetItemGrantWithResponse call
+func ParseSetItemGrantResponse(rsp *http.Response) (*SetItemGrantResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListItemChildrenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SetItemGrantResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ReadItemParentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemParentRead
-	JSON400      *N400
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ReadItemParentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReadItemParentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-type RestoreItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *N400
-	JSON404      *N404
-	JSON409      *N409
-	JSON500      *N500
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r RestoreItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RestoreItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
 
-// ListItemWithResponse request returning *ListItemResponse
-func (c *ClientWithResponses) ListItemWithResponse(ctx context.Context, params *ListItemParams, reqEditors ...RequestEditorFn) (*ListItemResponse, error) {
-	rsp, err := c.ListItem(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
-// CreateItemWithBodyWithResponse request with arbitrary body returning *CreateItemResponse
-func (c *ClientWithResponses) CreateItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateItemResponse, error) {
-	rsp, err := c.CreateItemWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
-func (c *ClientWithResponses) CreateItemWithResponse(ctx context.Context, body CreateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateItemResponse, error) {
-	rsp, err := c.CreateItem(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// DeleteItemWithResponse request returning *DeleteItemResponse
-func (c *ClientWithResponses) DeleteItemWithResponse(ctx context.Context, id uint32, params *DeleteItemParams, reqEditors ...RequestEditorFn) (*DeleteItemResponse, error) {
-	rsp, err := c.DeleteItem(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// ReadItemWithResponse request returning *ReadItemResponse
-func (c *ClientWithResponses) ReadItemWithResponse(ctx context.Context, id uint32, params *ReadItemParams, reqEditors ...RequestEditorFn) (*ReadItemResponse, error) {
-	rsp, err := c.ReadItem(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReadItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
 
-// UpdateItemWithBodyWithResponse request with arbitrary body returning *UpdateItemResponse
-func (c *ClientWithResponses) UpdateItemWithBodyWithResponse(ctx context.Context, id uint32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error) {
-	rsp, err := c.UpdateItemWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
 
-func (c *ClientWithResponses) UpdateItemWithResponse(ctx context.Context, id uint32, body UpdateItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateItemResponse, error) {
-	rsp, err := c.UpdateItem(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateItemResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
-// ListItemChildrenWithResponse request returning *ListItemChildrenResponse
-func (c *ClientWithResponses) ListItemChildrenWithResponse(ctx context.Context, id uint32, params *ListItemChildrenParams, reqEditors ...RequestEditorFn) (*ListItemChildrenResponse, error) {
-	rsp, err := c.ListItemChildren(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListItemChildrenResponse(rsp)
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-// ReadItemParentWithResponse request returning *ReadItemParentResponse
-func (c *ClientWithResponses) ReadItemParentWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*ReadItemParentResponse, error) {
-	rsp, err := c.ReadItemParent(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReadItemParentResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Grant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// RestoreItemWithResponse request returning *RestoreItemResponse
-func (c *ClientWithResponses) RestoreItemWithResponse(ctx context.Context, id uint32, reqEditors ...RequestEditorFn) (*RestoreItemResponse, error) {
-	rsp, err := c.RestoreItem(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseRestoreItemResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListItemResponse parses an HTTP response from a ListItemWithResponse call
-func ParseListItemResponse(rsp *http.Response) (*ListItemResponse, error) {
+// ParseListItemHistoryResponse parses an HTTP response from a ListItemHistoryWithResponse call
+func ParseListItemHistoryResponse(rsp *http.Response) (*ListItemHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListItemResponse{
+	response := &ListItemHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			// CurrentPage Page number (1-based)
 			CurrentPage int `json:"current_page"`
 
 			// Data List of items
-			Data []ItemList `json:"data"`
+			Data []ItemHistory `json:"data"`
 
 			// FirstPageUrl URL to the first page
 			FirstPageUrl string `json:"first_page_url"`
@@ -1370,434 +6754,682 @@ func ParseListItemResponse(rsp *http.Response) (*ListItemResponse, error) {
 			// To Index (1-based) of the last item in the current page
 			To int `json:"to"`
 
-			// Total Total number of items
-			Total int `json:"total"`
+			// Total Total number of items
+			Total int `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadItemParentResponse parses an HTTP response from a ReadItemParentWithResponse call
+func ParseReadItemParentResponse(rsp *http.Response) (*ReadItemParentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadItemParentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemParentRead
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadItemRelationResponse parses an HTTP response from a ReadItemRelationWithResponse call
+func ParseReadItemRelationResponse(rsp *http.Response) (*ReadItemRelationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadItemRelationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.ApplicationproblemJSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemRelation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateItemResponse parses an HTTP response from a CreateItemWithResponse call
-func ParseCreateItemResponse(rsp *http.Response) (*CreateItemResponse, error) {
+// ParseRestoreItemResponse parses an HTTP response from a RestoreItemWithResponse call
+func ParseRestoreItemResponse(rsp *http.Response) (*RestoreItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateItemResponse{
+	response := &RestoreItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ItemCreate
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseDeleteItemResponse parses an HTTP response from a DeleteItemWithResponse call
-func ParseDeleteItemResponse(rsp *http.Response) (*DeleteItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseReadItemResponse parses an HTTP response from a ReadItemWithResponse call
-func ParseReadItemResponse(rsp *http.Response) (*ReadItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.ApplicationproblemJSON400 = &dest
 
-	response := &ReadItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemRead
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.ApplicationproblemJSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.ApplicationproblemJSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateItemResponse parses an HTTP response from a UpdateItemWithResponse call
-func ParseUpdateItemResponse(rsp *http.Response) (*UpdateItemResponse, error) {
+// ParseRevertItemResponse parses an HTTP response from a RevertItemWithResponse call
+func ParseRevertItemResponse(rsp *http.Response) (*RevertItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateItemResponse{
+	response := &RevertItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemUpdate
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 413:
+		var dest N413ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseListItemChildrenResponse parses an HTTP response from a ListItemChildrenWithResponse call
-func ParseListItemChildrenResponse(rsp *http.Response) (*ListItemChildrenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ListItemChildrenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			// CurrentPage Page number (1-based)
-			CurrentPage int `json:"current_page"`
-
-			// Data List of items
-			Data []ItemList `json:"data"`
-
-			// FirstPageUrl URL to the first page
-			FirstPageUrl string `json:"first_page_url"`
-
-			// From Index (1-based) of the first item in the current page
-			From int `json:"from"`
+		response.JSON413 = &dest
 
-			// LastPage Last page number
-			LastPage int `json:"last_page"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 422:
+		var dest N422ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
-			// LastPageUrl URL to the last page
-			LastPageUrl string `json:"last_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
-			// NextPageUrl URL to the next page
-			NextPageUrl string `json:"next_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-			// Path Base path of the request
-			Path string `json:"path"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-			// PerPage Number of items per page
-			PerPage int `json:"per_page"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-			// PrevPageUrl URL to the previous page
-			PrevPageUrl string `json:"prev_page_url"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
 
-			// To Index (1-based) of the last item in the current page
-			To int `json:"to"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
-			// Total Total number of items
-			Total int `json:"total"`
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 413:
+		var dest N413ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSON413 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 422:
+		var dest N422ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON422 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.ApplicationproblemJSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemUpdate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReadItemParentResponse parses an HTTP response from a ReadItemParentWithResponse call
-func ParseReadItemParentResponse(rsp *http.Response) (*ReadItemParentResponse, error) {
+// ParseListItemSiblingsResponse parses an HTTP response from a ListItemSiblingsWithResponse call
+func ParseListItemSiblingsResponse(rsp *http.Response) (*ListItemSiblingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReadItemParentResponse{
+	response := &ListItemSiblingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemParentRead
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 400:
+		var dest N400ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 401:
+		var dest N401ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 403:
+		var dest N403ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 404:
+		var dest N404ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 409:
+		var dest N409ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 429:
+		var dest N429ApplicationJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/json" && rsp.StatusCode == 500:
+		var dest N500ApplicationJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON500 = &dest
 
-	}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 400:
+		var dest N400ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-	return response, nil
-}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 401:
+		var dest N401ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-// ParseRestoreItemResponse parses an HTTP response from a RestoreItemWithResponse call
-func ParseRestoreItemResponse(rsp *http.Response) (*RestoreItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 403:
+		var dest N403ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
 
-	response := &RestoreItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 404:
+		var dest N404ApplicationProblemPlusJSON
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest N400
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 409:
+		var dest N409ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest N404
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 429:
+		var dest N429ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.ApplicationproblemJSON429 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest N409
+	case rsp.Header.Get("Content-Type") == "application/problem+json" && rsp.StatusCode == 500:
+		var dest N500ApplicationProblemPlusJSON
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// CurrentPage Page number (1-based), 0 in cursor pagination
+			CurrentPage int `json:"current_page"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest N500
+			// Data List of items
+			Data []ItemList `json:"data"`
+
+			// FirstPageUrl URL to the first page
+			FirstPageUrl string `json:"first_page_url"`
+
+			// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+			From int `json:"from"`
+
+			// LastPage Last page number, 0 in cursor pagination
+			LastPage int `json:"last_page"`
+
+			// LastPageUrl URL to the last page, empty in cursor pagination
+			LastPageUrl string `json:"last_page_url"`
+
+			// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+			NextCursor *string `json:"next_cursor,omitempty"`
+
+			// NextPageUrl URL to the next page
+			NextPageUrl string `json:"next_page_url"`
+
+			// Path Base path of the request
+			Path string `json:"path"`
+
+			// PerPage Number of items per page
+			PerPage int `json:"per_page"`
+
+			// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+			PrevCursor *string `json:"prev_cursor,omitempty"`
+
+			// PrevPageUrl URL to the previous page
+			PrevPageUrl string `json:"prev_page_url"`
+
+			// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+			To int `json:"to"`
+
+			// Total Total number of items, 0 in cursor pagination
+			Total int `json:"total"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
@@ -77,6 +77,11 @@ func fixtureDatabase() (err error) {
 	if err != nil {
 		return
 	}
+	//goland:noinspection SqlNoDataSourceInspection,SqlWithoutWhere,SqlResolve
+	_, err = testDb.Exec("TRUNCATE TABLE `item_histories`")
+	if err != nil {
+		return
+	}
 	_, err = testDb.Exec(
 		"INSERT INTO `items` (`id`, `parent_id`, `name`, `created_at`) VALUES" +
 			strings.Join(rows, ","),
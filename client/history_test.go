@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ListItemHistoryWithResponse_returns_changes(t *testing.T) {
+	setupTest(t)
+	fix := fixture[9]
+	c, err := NewClientWithResponses(testServer, WithHTTPClient(&http.Client{}))
+	assert.Nil(t, err)
+	name := "history test"
+	body := UpdateItemJSONRequestBody{Name: &name}
+	_, err = c.UpdateItemWithResponse(context.TODO(), fix.Id, body)
+	assert.Nil(t, err)
+	res, err := c.ListItemHistoryWithResponse(
+		context.TODO(), fix.Id, &ListItemHistoryParams{},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, 1, res.JSON200.Total)
+	change := res.JSON200.Data[0]
+	assert.Equal(t, Update, change.Action)
+	assert.Equal(t, fix.Name, *change.NameBefore)
+	assert.Equal(t, name, *change.NameAfter)
+}
+
+func Test_RevertItemWithResponse_reverts_to_revision(t *testing.T) {
+	setupTest(t)
+	fix := fixture[9]
+	c, err := NewClientWithResponses(testServer, WithHTTPClient(&http.Client{}))
+	assert.Nil(t, err)
+	for _, name := range []string{"revision 1", "revision 2"} {
+		body := UpdateItemJSONRequestBody{Name: &name}
+		_, err = c.UpdateItemWithResponse(context.TODO(), fix.Id, body)
+		assert.Nil(t, err)
+	}
+	res, err := c.RevertItemWithResponse(
+		context.TODO(), fix.Id, RevertItemJSONRequestBody{Revision: 1},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, "revision 1", res.JSON200.Name)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// ListItemPages iterates over pages of items in cursor pagination, starting
+// from the cursor of `params` if set. Iteration ends after the last page, or
+// after yielding the first error.
+func (c *ClientWithResponses) ListItemPages(
+	ctx context.Context, params *ListItemParams, reqEditors ...RequestEditorFn,
+) iter.Seq2[[]ItemList, error] {
+	return func(yield func([]ItemList, error) bool) {
+		p := ListItemParams{}
+		if nil != params {
+			p = *params
+		}
+		mode := ListItemParamsPaginationCursor
+		p.Pagination = &mode
+		for {
+			res, err := c.ListItemWithResponse(ctx, &p, reqEditors...)
+			if nil == err && nil == res.JSON200 {
+				err = fmt.Errorf("unexpected status %s", res.Status())
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(res.JSON200.Data, nil) || nil == res.JSON200.NextCursor {
+				return
+			}
+			p.Cursor = res.JSON200.NextCursor
+		}
+	}
+}
+
+// ListItemChildrenPages is the ListItemChildren counterpart of ListItemPages.
+// Descendants are listed in a single page if `recurse` is true.
+func (c *ClientWithResponses) ListItemChildrenPages(
+	ctx context.Context, id uint32, params *ListItemChildrenParams,
+	reqEditors ...RequestEditorFn,
+) iter.Seq2[[]ItemList, error] {
+	return func(yield func([]ItemList, error) bool) {
+		p := ListItemChildrenParams{}
+		if nil != params {
+			p = *params
+		}
+		mode := ListItemChildrenParamsPaginationCursor
+		p.Pagination = &mode
+		for {
+			res, err := c.ListItemChildrenWithResponse(
+				ctx, id, &p, reqEditors...,
+			)
+			if nil == err && nil == res.JSON200 {
+				err = fmt.Errorf("unexpected status %s", res.Status())
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(res.JSON200.Data, nil) || nil == res.JSON200.NextCursor {
+				return
+			}
+			p.Cursor = res.JSON200.NextCursor
+		}
+	}
+}
+
+// ListItemSiblingsPages is the ListItemSiblings counterpart of ListItemPages.
+func (c *ClientWithResponses) ListItemSiblingsPages(
+	ctx context.Context, id uint32, params *ListItemSiblingsParams,
+	reqEditors ...RequestEditorFn,
+) iter.Seq2[[]ItemList, error] {
+	return func(yield func([]ItemList, error) bool) {
+		p := ListItemSiblingsParams{}
+		if nil != params {
+			p = *params
+		}
+		mode := ListItemSiblingsParamsPaginationCursor
+		p.Pagination = &mode
+		for {
+			res, err := c.ListItemSiblingsWithResponse(
+				ctx, id, &p, reqEditors...,
+			)
+			if nil == err && nil == res.JSON200 {
+				err = fmt.Errorf("unexpected status %s", res.Status())
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(res.JSON200.Data, nil) || nil == res.JSON200.NextCursor {
+				return
+			}
+			p.Cursor = res.JSON200.NextCursor
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ListItemPages_iterates_all_items(t *testing.T) {
+	setupTest(t)
+	hc := http.Client{}
+	c, err := NewClientWithResponses(testServer, WithHTTPClient(&hc))
+	assert.Nil(t, err)
+	perPage := 20
+	var items []ItemList
+	pages := 0
+	for page, err := range c.ListItemPages(
+		context.TODO(), &ListItemParams{PerPage: &perPage},
+	) {
+		assert.Nil(t, err)
+		items = append(items, page...)
+		pages++
+	}
+	assert.Equal(t, 3, pages)
+	assertJsonEquals(t, fixture, items)
+}
+
+func Test_ListItemChildrenPages_iterates_all_children(t *testing.T) {
+	setupTest(t)
+	hc := http.Client{}
+	c, err := NewClientWithResponses(testServer, WithHTTPClient(&hc))
+	assert.Nil(t, err)
+	perPage := 5
+	var items []ItemList
+	for page, err := range c.ListItemChildrenPages(
+		context.TODO(), 8, &ListItemChildrenParams{PerPage: &perPage},
+	) {
+		assert.Nil(t, err)
+		items = append(items, page...)
+	}
+	assertJsonEquals(t, fixture[39:], items)
+}
+
+func Test_ListItemSiblingsPages_iterates_all_siblings(t *testing.T) {
+	setupTest(t)
+	hc := http.Client{}
+	c, err := NewClientWithResponses(testServer, WithHTTPClient(&hc))
+	assert.Nil(t, err)
+	perPage := 5
+	var items []ItemList
+	for page, err := range c.ListItemSiblingsPages(
+		context.TODO(), 45, &ListItemSiblingsParams{PerPage: &perPage},
+	) {
+		assert.Nil(t, err)
+		items = append(items, page...)
+	}
+	assertJsonEquals(t, fixture[39:], items)
+}
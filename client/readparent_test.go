@@ -13,7 +13,9 @@ func Test_ReadParentWithResponse_returns_detail(t *testing.T) {
 	expected := fixture[0]
 	c, err := NewClientWithResponses(testServer, WithHTTPClient(&http.Client{}))
 	assert.Nil(t, err)
-	res, err := c.ReadItemParentWithResponse(context.TODO(), 2)
+	res, err := c.ReadItemParentWithResponse(
+		context.TODO(), 2, &ReadItemParentParams{},
+	)
 	assert.Nil(t, err)
 	assert.Equal(t, http.StatusOK, res.StatusCode())
 	assertJsonEquals(t, expected, res.JSON200)
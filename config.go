@@ -0,0 +1,427 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// Config is the configuration of the service. Values are taken from, in
+// increasing order of precedence: defaults, the configuration file,
+// environment variables, and command line flags.
+//
+// Each field is set by the key named by its `config` tag in the file, nested
+// in sections, by the environment variable named by its `env` tag, and by the
+// flag of the same name in kebab case, e.g. `RATE_LIMIT_READ` is set by
+// `limits.rate_read` in the file, and by the `-rate-limit-read` flag.
+type Config struct {
+	Listen          string        `config:"listen" env:"LISTEN" help:"TCP address to listen on"`
+	BaseURL         string        `config:"base_url" env:"BASE_URL" help:"URL the service is reachable at"`
+	BaseURI         string        `config:"base_uri" env:"BASE_URI" help:"path prefix of all item endpoints"`
+	GinMode         string        `config:"gin_mode" env:"GIN_MODE" help:"gin mode: debug, release or test"`
+	ErrorFormat     string        `config:"error_format" env:"ERROR_FORMAT" help:"format of error responses: json or problem"`
+	ShutdownDelay   time.Duration `config:"shutdown_delay" env:"SHUTDOWN_DELAY" help:"time to keep serving after reporting not ready on shutdown"`
+	ShutdownTimeout time.Duration `config:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" help:"time given to in-flight requests on shutdown"`
+
+	DB       DBConfig       `config:"db"`
+	Auth     AuthConfig     `config:"auth"`
+	Limits   LimitsConfig   `config:"limits"`
+	Events   EventsConfig   `config:"events"`
+	Webhooks WebhooksConfig `config:"webhooks"`
+	Log      LogConfig      `config:"log"`
+	Metrics  MetricsConfig  `config:"metrics"`
+	Docs     DocsConfig     `config:"docs"`
+	Tracing  TracingConfig  `config:"tracing"`
+}
+
+// DBConfig configures the database connection. The DSN takes precedence over
+// the other MySQL specific fields.
+type DBConfig struct {
+	Driver    string `config:"driver" env:"DB_DRIVER" help:"database driver, e.g. mysql"`
+	DSN       string `config:"dsn" env:"DB_DSN" help:"data source name"`
+	User      string `config:"user" env:"DB_USER" help:"MySQL user"`
+	Password  string `config:"password" env:"DB_PASSWORD" help:"MySQL password"`
+	Host      string `config:"host" env:"DB_HOST" help:"MySQL host"`
+	Name      string `config:"name" env:"DB_NAME" help:"MySQL database name"`
+	Protocol  string `config:"protocol" env:"DB_PROTOCOL" help:"MySQL protocol"`
+	Collation string `config:"collation" env:"DB_COLLATION" help:"MySQL collation"`
+	Timezone  string `config:"timezone" env:"DB_TIMEZONE" help:"MySQL timezone"`
+}
+
+// AuthConfig configures authentication & authorization. Authentication is
+// disabled if none of the key files is set.
+type AuthConfig struct {
+	JWTKeyFile  string   `config:"jwt_key_file" env:"AUTH_JWT_KEY_FILE" help:"file of the key verifying JWTs"`
+	JWKSFile    string   `config:"jwks_file" env:"AUTH_JWKS_FILE" help:"JWKS file of keys verifying JWTs"`
+	JWTIssuer   string   `config:"jwt_issuer" env:"AUTH_JWT_ISSUER" help:"required JWT issuer"`
+	JWTAudience string   `config:"jwt_audience" env:"AUTH_JWT_AUDIENCE" help:"required JWT audience"`
+	APIKeysFile string   `config:"api_keys_file" env:"AUTH_API_KEYS_FILE" help:"file of API keys"`
+	Admins      []string `config:"admins" env:"AUTH_ADMINS" help:"comma separated subjects allowed everything"`
+}
+
+// LimitsConfig configures rate limits, the maximum size of request bodies,
+// and the maximum number of items copied at once. Requests of classes without
+// rate limit are not limited.
+type LimitsConfig struct {
+	RateRead       string   `config:"rate_read" env:"RATE_LIMIT_READ" help:"rate limit of reads, e.g. 100/1m"`
+	RateWrite      string   `config:"rate_write" env:"RATE_LIMIT_WRITE" help:"rate limit of writes, e.g. 10/1m"`
+	RateRecursive  string   `config:"rate_recursive" env:"RATE_LIMIT_RECURSIVE" help:"rate limit of recursive requests, e.g. 5/1m"`
+	TrustedProxies []string `config:"trusted_proxies" env:"TRUSTED_PROXIES" help:"comma separated proxies trusted to report client IPs"`
+	MaxBodySize    int      `config:"max_body_size" env:"MAX_BODY_SIZE" help:"maximum size of request bodies in bytes"`
+	MaxCopySize    int      `config:"max_copy_size" env:"MAX_COPY_SIZE" help:"maximum number of items copied at once"`
+}
+
+// EventsConfig configures change events.
+type EventsConfig struct {
+	LogSize         int           `config:"log_size" env:"EVENT_LOG_SIZE" help:"number of events kept in memory"`
+	LogFile         string        `config:"log_file" env:"EVENT_LOG_FILE" help:"file to append events to"`
+	OutboxRetention time.Duration `config:"outbox_retention" env:"OUTBOX_RETENTION" help:"how long relayed events are kept"`
+}
+
+// WebhooksConfig configures webhook deliveries.
+type WebhooksConfig struct {
+	MaxAttempts int           `config:"max_attempts" env:"WEBHOOK_MAX_ATTEMPTS" help:"attempts of each delivery"`
+	Backoff     time.Duration `config:"backoff" env:"WEBHOOK_BACKOFF" help:"delay before the first retry"`
+	Timeout     time.Duration `config:"timeout" env:"WEBHOOK_TIMEOUT" help:"timeout of each attempt"`
+}
+
+// LogConfig configures logging.
+type LogConfig struct {
+	Level  string `config:"level" env:"LOG_LEVEL" help:"minimum log level: debug, info, warn or error"`
+	Format string `config:"format" env:"LOG_FORMAT" help:"log format: json or text"`
+}
+
+// MetricsConfig configures Prometheus metrics.
+type MetricsConfig struct {
+	Enabled bool `config:"enabled" env:"METRICS_ENABLED" help:"serve Prometheus metrics at /metrics"`
+}
+
+// DocsConfig configures the interactive API documentation.
+type DocsConfig struct {
+	Enabled bool `config:"enabled" env:"DOCS_ENABLED" help:"serve API documentation at /docs"`
+}
+
+// TracingConfig configures OpenTelemetry tracing. Tracing is disabled unless
+// an exporter is set.
+type TracingConfig struct {
+	Exporter string `config:"exporter" env:"OTEL_TRACES_EXPORTER" help:"trace exporter: otlp, console or none"`
+	File     string `config:"file" env:"TRACES_FILE" help:"file to append spans to with the console exporter"`
+}
+
+// defaultConfig returns the configuration used unless overridden.
+func defaultConfig() *Config {
+	return &Config{
+		Listen:          ":80",
+		BaseURI:         schema.BaseUri,
+		GinMode:         gin.ReleaseMode,
+		ErrorFormat:     errorFormatJson,
+		ShutdownTimeout: 30 * time.Second,
+		DB: DBConfig{
+			Protocol:  "tcp",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		Limits: LimitsConfig{
+			MaxBodySize: defaultMaxBodySize,
+			MaxCopySize: defaultMaxCopySize,
+		},
+		Events: EventsConfig{
+			LogSize:         defaultEventLogSize,
+			OutboxRetention: 7 * 24 * time.Hour,
+		},
+		Webhooks: WebhooksConfig{
+			MaxAttempts: 8,
+			Backoff:     time.Second,
+			Timeout:     10 * time.Second,
+		},
+		Log: LogConfig{Level: "info", Format: "json"},
+	}
+}
+
+// configField is a field of the configuration that can be set.
+type configField struct {
+	// key is the dot separated path of the field in the configuration file.
+	key   string
+	env   string
+	help  string
+	value reflect.Value
+}
+
+// flag returns the name of the command line flag setting the field.
+func (f configField) flag() string {
+	return strings.ReplaceAll(strings.ToLower(f.env), "_", "-")
+}
+
+// set parses the string and assigns it to the field.
+func (f configField) set(s string) error {
+	switch f.value.Interface().(type) {
+	case string:
+		f.value.SetString(s)
+	case bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+		f.value.SetBool(b)
+	case int:
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		f.value.SetInt(int64(i))
+	case time.Duration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", s)
+		}
+		f.value.SetInt(int64(d))
+	case []string:
+		var list []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); "" != item {
+				list = append(list, item)
+			}
+		}
+		f.value.Set(reflect.ValueOf(list))
+	default:
+		panic("unsupported config field type " + f.value.Type().String())
+	}
+	return nil
+}
+
+// fields returns all fields of the configuration that can be set.
+func (c *Config) fields() []configField {
+	var fields []configField
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		for i := range v.NumField() {
+			sf := v.Type().Field(i)
+			key := prefix + sf.Tag.Get("config")
+			env, ok := sf.Tag.Lookup("env")
+			if !ok {
+				walk(v.Field(i), key+".")
+				continue
+			}
+			fields = append(
+				fields, configField{
+					key: key, env: env, help: sf.Tag.Get("help"),
+					value: v.Field(i),
+				},
+			)
+		}
+	}
+	walk(reflect.ValueOf(c).Elem(), "")
+	return fields
+}
+
+// loadConfig loads the configuration using the given command line arguments,
+// and validates it. The configuration file is set by the `-config` flag, or
+// the `CONFIG_FILE` environment variable. Its format is determined by its
+// extension, either YAML or TOML.
+func loadConfig(args []string) (*Config, error) {
+	cfg := defaultConfig()
+	fields := cfg.fields()
+	fs := flag.NewFlagSet("simple-tree", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "configuration file")
+	// flags are applied after the file & environment variables
+	flags := map[string]string{}
+	for _, f := range fields {
+		fs.Func(
+			f.flag(), f.help, func(s string) error {
+				flags[f.env] = s
+				return nil
+			},
+		)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if "" != *path {
+		if err := cfg.loadFile(*path, fields); err != nil {
+			return nil, fmt.Errorf("%s: %w", *path, err)
+		}
+	}
+	for _, f := range fields {
+		if s, ok := os.LookupEnv(f.env); ok && "" != s {
+			if err := f.set(s); err != nil {
+				return nil, fmt.Errorf("%s: %w", f.env, err)
+			}
+		}
+	}
+	for _, f := range fields {
+		if s, ok := flags[f.env]; ok {
+			if err := f.set(s); err != nil {
+				return nil, fmt.Errorf("-%s: %w", f.flag(), err)
+			}
+		}
+	}
+	cfg.BaseURI = strings.TrimRight(cfg.BaseURI, "/")
+	return cfg, cfg.validate()
+}
+
+// loadFile sets fields from the configuration file. Unknown keys are errors,
+// to catch typos.
+func (c *Config) loadFile(path string, fields []configField) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	doc := map[string]any{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &doc)
+	case ".toml":
+		err = toml.Unmarshal(b, &doc)
+	default:
+		return fmt.Errorf("unknown configuration format %q", ext)
+	}
+	if err != nil {
+		return err
+	}
+	values := map[string]any{}
+	flattenConfig(doc, "", values)
+	for _, f := range fields {
+		val, ok := values[f.key]
+		if !ok {
+			continue
+		}
+		delete(values, f.key)
+		if list, ok := val.([]any); ok {
+			items := make([]string, len(list))
+			for i, item := range list {
+				items[i] = fmt.Sprint(item)
+			}
+			val = strings.Join(items, ",")
+		}
+		if err := f.set(fmt.Sprint(val)); err != nil {
+			return fmt.Errorf("%s: %w", f.key, err)
+		}
+	}
+	for key := range values {
+		return fmt.Errorf("unknown key %q", key)
+	}
+	return nil
+}
+
+// flattenConfig collects values nested in sections of the document, keyed by
+// their dot separated path.
+func flattenConfig(doc map[string]any, prefix string, values map[string]any) {
+	for key, val := range doc {
+		if section, ok := val.(map[string]any); ok {
+			flattenConfig(section, prefix+key+".", values)
+			continue
+		}
+		values[prefix+key] = val
+	}
+}
+
+// validate returns all problems found in the configuration.
+func (c *Config) validate() error {
+	var errs []error
+	fail := func(field, format string, args ...any) {
+		errs = append(errs, fmt.Errorf(field+": "+format, args...))
+	}
+	if "" == c.Listen {
+		fail("LISTEN", "is required")
+	}
+	if u, err := url.Parse(c.BaseURL); "" == c.BaseURL {
+		fail("BASE_URL", "is required")
+	} else if err != nil || !u.IsAbs() {
+		fail("BASE_URL", "must be an absolute URL")
+	}
+	if "" != c.BaseURI && (!strings.HasPrefix(c.BaseURI, "/") ||
+		strings.ContainsAny(c.BaseURI, ":*{}?#")) {
+		fail("BASE_URI", "must be a plain path starting with /")
+	}
+	if !slices.Contains(
+		[]string{gin.DebugMode, gin.ReleaseMode, gin.TestMode}, c.GinMode,
+	) {
+		fail("GIN_MODE", "unknown mode %q", c.GinMode)
+	}
+	if !slices.Contains(
+		[]string{errorFormatJson, errorFormatProblem}, c.ErrorFormat,
+	) {
+		fail("ERROR_FORMAT", "unknown format %q", c.ErrorFormat)
+	}
+	if c.ShutdownDelay < 0 {
+		fail("SHUTDOWN_DELAY", "must not be negative")
+	}
+	if c.ShutdownTimeout <= 0 {
+		fail("SHUTDOWN_TIMEOUT", "must be positive")
+	}
+	switch {
+	case "" == c.DB.Driver:
+		fail("DB_DRIVER", "is required")
+	case "" != c.DB.DSN:
+	case dialect.MySQL != c.DB.Driver:
+		fail("DB_DSN", "is required")
+	default:
+		for _, field := range []struct{ name, val string }{
+			{"DB_USER", c.DB.User},
+			{"DB_PASSWORD", c.DB.Password},
+			{"DB_HOST", c.DB.Host},
+			{"DB_NAME", c.DB.Name},
+		} {
+			if "" == field.val {
+				fail(field.name, "is required without DB_DSN")
+			}
+		}
+		if _, err := time.LoadLocation(c.DB.Timezone); err != nil {
+			fail("DB_TIMEZONE", "%s", err)
+		}
+	}
+	if _, err := getRateLimits(c.Limits); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Limits.MaxBodySize <= 0 {
+		fail("MAX_BODY_SIZE", "must be positive")
+	}
+	if c.Limits.MaxCopySize <= 0 {
+		fail("MAX_COPY_SIZE", "must be positive")
+	}
+	if c.Events.LogSize < 0 {
+		fail("EVENT_LOG_SIZE", "must not be negative")
+	}
+	if c.Events.OutboxRetention <= 0 {
+		fail("OUTBOX_RETENTION", "must be positive")
+	}
+	if c.Webhooks.MaxAttempts <= 0 {
+		fail("WEBHOOK_MAX_ATTEMPTS", "must be positive")
+	}
+	if c.Webhooks.Backoff <= 0 {
+		fail("WEBHOOK_BACKOFF", "must be positive")
+	}
+	if c.Webhooks.Timeout <= 0 {
+		fail("WEBHOOK_TIMEOUT", "must be positive")
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		fail("LOG_LEVEL", "unknown level %q", c.Log.Level)
+	}
+	if !slices.Contains(
+		[]string{"json", "text"}, strings.ToLower(c.Log.Format),
+	) {
+		fail("LOG_FORMAT", "unknown format %q", c.Log.Format)
+	}
+	if !slices.Contains(
+		[]string{"", "none", "otlp", "console"}, c.Tracing.Exporter,
+	) {
+		fail("OTEL_TRACES_EXPORTER", "unknown exporter %q", c.Tracing.Exporter)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clearConfigEnv clears all environment variables of the configuration until
+// the test finishes.
+func clearConfigEnv(tb testing.TB) {
+	tb.Setenv("CONFIG_FILE", "")
+	for _, f := range defaultConfig().fields() {
+		tb.Setenv(f.env, "")
+	}
+}
+
+const testYamlConfig = `
+listen: ":8080"
+base_url: http://example.com
+shutdown_timeout: 5s
+db:
+  driver: sqlite3
+  dsn: ":memory:"
+auth:
+  admins: [alice, bob]
+limits:
+  rate_read: 10/1s
+  max_body_size: 1024
+metrics:
+  enabled: true
+`
+
+const testTomlConfig = `
+listen = ":8080"
+base_url = "http://example.com"
+
+[db]
+driver = "sqlite3"
+dsn = ":memory:"
+
+[webhooks]
+backoff = "2s"
+max_attempts = 3
+`
+
+func Test_loadConfig_uses_defaults(t *testing.T) {
+	clearConfigEnv(t)
+	cfg, err := loadConfig(
+		[]string{
+			"-base-url", "http://localhost", "-db-driver", "mysql",
+			"-db-dsn", "dsn",
+		},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, ":80", cfg.Listen)
+	assert.Equal(t, time.Duration(0), cfg.ShutdownDelay)
+	assert.Equal(t, 30*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, defaultMaxBodySize, cfg.Limits.MaxBodySize)
+	assert.Equal(t, defaultMaxCopySize, cfg.Limits.MaxCopySize)
+	assert.Equal(t, 8, cfg.Webhooks.MaxAttempts)
+	assert.Equal(t, "info", cfg.Log.Level)
+	assert.False(t, cfg.Metrics.Enabled)
+}
+
+func Test_loadConfig_reads_yaml_file(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeTestFile(t, "config.yaml", testYamlConfig)
+	cfg, err := loadConfig([]string{"-config", path})
+	assert.Nil(t, err)
+	assert.Equal(t, ":8080", cfg.Listen)
+	assert.Equal(t, "http://example.com", cfg.BaseURL)
+	assert.Equal(t, 5*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, "sqlite3", cfg.DB.Driver)
+	assert.Equal(t, []string{"alice", "bob"}, cfg.Auth.Admins)
+	assert.Equal(t, "10/1s", cfg.Limits.RateRead)
+	assert.Equal(t, 1024, cfg.Limits.MaxBodySize)
+	assert.True(t, cfg.Metrics.Enabled)
+}
+
+func Test_loadConfig_reads_toml_file_set_by_env(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("CONFIG_FILE", writeTestFile(t, "config.toml", testTomlConfig))
+	cfg, err := loadConfig(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ":8080", cfg.Listen)
+	assert.Equal(t, "sqlite3", cfg.DB.Driver)
+	assert.Equal(t, 2*time.Second, cfg.Webhooks.Backoff)
+	assert.Equal(t, 3, cfg.Webhooks.MaxAttempts)
+}
+
+func Test_loadConfig_env_overrides_file_and_flags_override_env(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeTestFile(t, "config.yaml", testYamlConfig)
+	t.Setenv("LISTEN", ":9090")
+	t.Setenv("AUTH_ADMINS", "carol")
+	t.Setenv("MAX_BODY_SIZE", "2048")
+	cfg, err := loadConfig(
+		[]string{"-config", path, "-max-body-size", "4096"},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, ":9090", cfg.Listen)
+	assert.Equal(t, []string{"carol"}, cfg.Auth.Admins)
+	assert.Equal(t, 4096, cfg.Limits.MaxBodySize)
+	assert.Equal(t, "http://example.com", cfg.BaseURL)
+}
+
+func Test_loadConfig_rejects_unknown_file_keys(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeTestFile(t, "config.yaml", "db:\n  drvier: mysql\n")
+	_, err := loadConfig([]string{"-config", path})
+	assert.ErrorContains(t, err, `unknown key "db.drvier"`)
+	path = writeTestFile(t, "config.json", "{}")
+	_, err = loadConfig([]string{"-config", path})
+	assert.ErrorContains(t, err, "unknown configuration format")
+}
+
+func Test_loadConfig_rejects_unparsable_values(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("WEBHOOK_BACKOFF", "soon")
+	_, err := loadConfig(nil)
+	assert.ErrorContains(t, err, `WEBHOOK_BACKOFF: invalid duration "soon"`)
+	t.Setenv("WEBHOOK_BACKOFF", "")
+	_, err = loadConfig([]string{"-metrics-enabled", "maybe"})
+	assert.ErrorContains(t, err, `-metrics-enabled: invalid boolean "maybe"`)
+	_, err = loadConfig([]string{"-h"})
+	assert.ErrorIs(t, err, flag.ErrHelp)
+}
+
+func Test_loadConfig_reports_all_invalid_values(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("DB_DRIVER", "mysql")
+	t.Setenv("LOG_FORMAT", "xml")
+	t.Setenv("EVENT_LOG_SIZE", "-1")
+	t.Setenv("SHUTDOWN_DELAY", "-1s")
+	_, err := loadConfig(nil)
+	assert.ErrorContains(t, err, "BASE_URL: is required")
+	assert.ErrorContains(t, err, "DB_USER: is required without DB_DSN")
+	assert.ErrorContains(t, err, "DB_NAME: is required without DB_DSN")
+	assert.ErrorContains(t, err, `LOG_FORMAT: unknown format "xml"`)
+	assert.ErrorContains(t, err, "EVENT_LOG_SIZE: must not be negative")
+	assert.ErrorContains(t, err, "SHUTDOWN_DELAY: must not be negative")
+	t.Setenv("BASE_URL", "example.com")
+	_, err = loadConfig(nil)
+	assert.ErrorContains(t, err, "BASE_URL: must be an absolute URL")
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/eidng8/go-utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+)
+
+const (
+	paramCursor      = "cursor"
+	paramPagination  = "pagination"
+	paginationCursor = "cursor"
+	cursorAfter      = "after"
+	cursorBefore     = "before"
+)
+
+// cursorPaginated tells whether cursor pagination is requested, by either the
+// `pagination` or the `cursor` parameter.
+func cursorPaginated[P ~string](pagination *P, cursor *string) bool {
+	return nil != cursor ||
+		(nil != pagination && paginationCursor == string(*pagination))
+}
+
+// pageCursor is the position of a page in cursor pagination. Pages are
+// ordered by item ID, and start right after, or end right before, the ID of
+// the cursor. Cursors are encoded to opaque strings for clients.
+type pageCursor struct {
+	before bool
+	id     uint32
+}
+
+func (c pageCursor) String() string {
+	dir := cursorAfter
+	if c.before {
+		dir = cursorBefore
+	}
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%s:%d", dir, c.id)),
+	)
+}
+
+// parseCursor decodes the cursor sent by the client. A nil cursor is the
+// first page.
+func parseCursor(cursor *string) (*pageCursor, error) {
+	if nil == cursor {
+		return nil, nil
+	}
+	s := *cursor
+	invalid := newApiError(
+		http.StatusBadRequest, fmt.Errorf("invalid cursor %q", s),
+		[]FieldError{{In: "query", Field: paramCursor, Message: "invalid cursor"}},
+	)
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, invalid
+	}
+	dir, id, _ := strings.Cut(string(b), ":")
+	n, err := strconv.ParseUint(id, 10, 32)
+	if err != nil || (cursorAfter != dir && cursorBefore != dir) {
+		return nil, invalid
+	}
+	return &pageCursor{before: cursorBefore == dir, id: uint32(n)}, nil
+}
+
+// keysetFetch returns at most `limit` rows beyond the cursor, nearest to the
+// cursor first, i.e. in descending order of ID if the cursor is `before`.
+// A nil cursor fetches from the first row.
+type keysetFetch[V any] func(c *pageCursor, limit int) ([]*V, error)
+
+// cursorPage is a page of rows, linked to adjacent pages by cursors in cursor
+// pagination. Cursors are empty in offset pagination.
+type cursorPage[V any] struct {
+	*paginate.PaginatedList[V]
+	NextCursor string
+	PrevCursor string
+}
+
+// getCursorPage renders the page of the cursor given by the `cursor` query
+// parameter, using `fetch` to query the rows, and `key` to tell their IDs.
+// Only adjacent pages having rows are linked. Rows are neither counted nor
+// skipped, so counts and page numbers of the page are left 0.
+func getCursorPage[V any](
+	gc *gin.Context, baseUrl string, cursor *string, fetch keysetFetch[V],
+	key func(*V) uint32,
+) (*cursorPage[V], error) {
+	c, err := parseCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	params := paginate.GetPaginationParams(gc)
+	perPage := params.GetPerPage()
+	// the extra row tells whether there are more rows beyond the page
+	rows, err := fetch(c, perPage+1)
+	if err != nil {
+		return nil, err
+	}
+	more := len(rows) > perPage
+	if more {
+		rows = rows[:perPage]
+	}
+	if nil != c && c.before {
+		slices.Reverse(rows)
+	}
+	page := &cursorPage[V]{
+		PaginatedList: &paginate.PaginatedList[V]{
+			PerPage: perPage,
+			Data:    rows,
+		},
+	}
+	if len(rows) > 0 {
+		first := pageCursor{before: true, id: key(rows[0])}
+		last := pageCursor{id: key(rows[len(rows)-1])}
+		var next, prev bool
+		switch {
+		case nil == c:
+			next = more
+		case c.before:
+			prev = more
+			next, err = exists(fetch, &last)
+		default:
+			next = more
+			prev, err = exists(fetch, &first)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if next {
+			page.NextCursor = last.String()
+		}
+		if prev {
+			page.PrevCursor = first.String()
+		}
+	}
+	paginator := paginate.Paginator[V, any]{BaseUrl: baseUrl, GinCtx: gc}
+	u := paginator.UrlWithoutPageParams()
+	q := u.Query()
+	q.Del(paramCursor)
+	q.Set(paramPagination, paginationCursor)
+	q.Set(paginate.ParamPerPage, strconv.Itoa(perPage))
+	u.RawQuery = q.Encode()
+	page.FirstPageUrl = u.String()
+	if "" != page.NextCursor {
+		page.NextPageUrl = utils.UrlWithQueryParam(
+			*u, paramCursor, page.NextCursor,
+		).String()
+	}
+	if "" != page.PrevCursor {
+		page.PrevPageUrl = utils.UrlWithQueryParam(
+			*u, paramCursor, page.PrevCursor,
+		).String()
+	}
+	page.Path = paginator.UrlWithoutQuery().String()
+	return page, nil
+}
+
+// exists tells whether there are rows beyond the cursor.
+func exists[V any](fetch keysetFetch[V], c *pageCursor) (bool, error) {
+	rows, err := fetch(c, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(rows) > 0, nil
+}
+
+// itemKeyset fetches items of the query for cursor pagination.
+func itemKeyset(
+	qc context.Context, query *ent.ItemQuery,
+) keysetFetch[ent.Item] {
+	return func(c *pageCursor, limit int) ([]*ent.Item, error) {
+		q := query.Clone().Limit(limit)
+		switch {
+		case nil == c:
+			q.Order(item.ByID())
+		case c.before:
+			q.Where(item.IDLT(c.id)).Order(item.ByID(sql.OrderDesc()))
+		default:
+			q.Where(item.IDGT(c.id)).Order(item.ByID())
+		}
+		return q.All(qc)
+	}
+}
+
+func itemKey(row *ent.Item) uint32 {
+	return row.ID
+}
+
+// snapshotKeyset is the snapshot counterpart of itemKeyset.
+func snapshotKeyset(
+	qc context.Context, query *ent.ItemHistoryQuery,
+) keysetFetch[ent.ItemHistory] {
+	return func(c *pageCursor, limit int) ([]*ent.ItemHistory, error) {
+		q := query.Clone().Limit(limit)
+		switch {
+		case nil == c:
+			q.Order(itemhistory.ByItemID())
+		case c.before:
+			q.Where(itemhistory.ItemIDLT(c.id)).
+				Order(itemhistory.ByItemID(sql.OrderDesc()))
+		default:
+			q.Where(itemhistory.ItemIDGT(c.id)).Order(itemhistory.ByItemID())
+		}
+		return q.All(qc)
+	}
+}
+
+func snapshotKey(row *ent.ItemHistory) uint32 {
+	return row.ItemID
+}
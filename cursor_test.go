@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func getCursorPageOf(
+	t *testing.T, engine http.Handler, uri string,
+) ListItem200JSONResponse {
+	res := serveRequest(engine, http.MethodGet, uri, "")
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	var page ListItem200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	return page
+}
+
+func pageIds(page ListItem200JSONResponse) []uint32 {
+	ids := make([]uint32, len(page.Data))
+	for i, row := range page.Data {
+		ids[i] = row.Id
+	}
+	return ids
+}
+
+func idRange(from, to uint32) []uint32 {
+	ids := make([]uint32, 0, to-from+1)
+	for id := from; id <= to; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func Test_ListItem_walks_pages_by_cursor(t *testing.T) {
+	server, engine, _, _ := setupGinTest(t)
+	base := schema.BaseUri + "?per_page=20&pagination=cursor"
+	page := getCursorPageOf(t, engine, base)
+	assert.Equal(t, idRange(1, 20), pageIds(page))
+	assert.Nil(t, page.PrevCursor)
+	assert.Empty(t, page.PrevPageUrl)
+	assert.Equal(t, 0, page.Total)
+	assert.Equal(t, 0, page.LastPage)
+	assert.Equal(t, 20, page.PerPage)
+	assert.Equal(t, server.BaseUrl(), page.Path)
+	assert.Equal(
+		t, server.BaseUrl()+"?pagination=cursor&per_page=20",
+		page.FirstPageUrl,
+	)
+	assert.Equal(
+		t,
+		server.BaseUrl()+"?cursor="+*page.NextCursor+
+			"&pagination=cursor&per_page=20",
+		page.NextPageUrl,
+	)
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, idRange(21, 40), pageIds(page))
+	assert.NotNil(t, page.PrevCursor)
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, idRange(41, 50), pageIds(page))
+	assert.Nil(t, page.NextCursor)
+	assert.Empty(t, page.NextPageUrl)
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.PrevCursor)
+	assert.Equal(t, idRange(21, 40), pageIds(page))
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.PrevCursor)
+	assert.Equal(t, idRange(1, 20), pageIds(page))
+	assert.Nil(t, page.PrevCursor)
+	assert.NotNil(t, page.NextCursor)
+}
+
+func Test_ListItem_cursor_pages_apply_filters(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.DeleteOneID(12).ExecX(context.Background())
+	base := schema.BaseUri + "?name=name+1&per_page=5&pagination=cursor"
+	page := getCursorPageOf(t, engine, base)
+	assert.Equal(t, []uint32{2, 11, 13, 14, 15}, pageIds(page))
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, []uint32{16, 17, 18, 19, 20}, pageIds(page))
+	assert.Nil(t, page.NextCursor)
+	page = getCursorPageOf(
+		t, engine, schema.BaseUri+"?trashed=1&name=name+1&per_page=5&pagination=cursor",
+	)
+	assert.Equal(t, []uint32{2, 11, 12, 13, 14}, pageIds(page))
+}
+
+func Test_ListItem_cursor_pages_of_empty_list(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	page := getCursorPageOf(t, engine, schema.BaseUri+"?name=none&pagination=cursor")
+	assert.Empty(t, page.Data)
+	assert.Nil(t, page.NextCursor)
+	assert.Nil(t, page.PrevCursor)
+}
+
+func Test_ListItem_reports_400_if_cursor_invalid(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	for _, cursor := range []string{
+		"!", pageCursor{id: 1}.String()[2:], "c2lkZXdheXM6MQ",
+	} {
+		res := serveRequest(
+			engine, http.MethodGet,
+			schema.BaseUri+"?cursor="+url.QueryEscape(cursor), "",
+		)
+		assert.Equal(t, http.StatusBadRequest, res.Code, cursor)
+		assert.JSONEq(
+			t,
+			`{"code":400,"status":"Bad Request","errors":[{"in":"query","field":"cursor","message":"invalid cursor"}]}`,
+			res.Body.String(),
+		)
+	}
+}
+
+func Test_ListItemChildren_walks_pages_by_cursor(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	entClient.Item.Update().Where(item.IDIn(idRange(2, 13)...)).
+		SetParentID(1).ExecX(context.Background())
+	base := schema.BaseUri + "/1/children?per_page=5&pagination=cursor"
+	page := getCursorPageOf(t, engine, base)
+	assert.Equal(t, idRange(2, 6), pageIds(page))
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, idRange(7, 11), pageIds(page))
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, idRange(12, 13), pageIds(page))
+	assert.Nil(t, page.NextCursor)
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.PrevCursor)
+	assert.Equal(t, idRange(7, 11), pageIds(page))
+	assert.NotNil(t, page.NextCursor)
+	assert.NotNil(t, page.PrevCursor)
+}
+
+func Test_ListItem_walks_snapshot_pages_by_cursor(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	backdateHistory(t, entClient, time.Hour)
+	entClient.Item.DeleteOneID(2).ExecX(context.Background())
+	asOf := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	base := schema.BaseUri + "?per_page=30&pagination=cursor&as_of=" +
+		url.QueryEscape(asOf)
+	page := getCursorPageOf(t, engine, base)
+	assert.Equal(t, idRange(1, 30), pageIds(page))
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.NextCursor)
+	assert.Equal(t, idRange(31, 50), pageIds(page))
+	assert.Nil(t, page.NextCursor)
+	page = getCursorPageOf(t, engine, base+"&cursor="+*page.PrevCursor)
+	assert.Equal(t, idRange(1, 30), pageIds(page))
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"embed"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// docsAssets holds the Swagger UI bundle rendering the docs page, pinned to
+// v5.18.2, so that the page works without access to any CDN.
+//
+//go:embed docs/swagger-ui-bundle.js docs/swagger-ui.css
+var docsAssets embed.FS
+
+// docsPage renders the spec with Swagger UI. URLs are relative to the page at
+// `/docs`, so that it keeps working behind a proxy serving it under a prefix.
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>Simple Tree API</title>
+  <link rel="stylesheet" href="docs/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="docs/swagger-ui-bundle.js"></script>
+  <script>
+    SwaggerUIBundle({url: "openapi.json", dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>
+`
+
+// specHandlers returns handlers serving the spec as JSON and YAML, with its
+// `servers` set to the given base URL. Paths of the spec are expected to be
+// rebased already.
+func specHandlers(
+	swagger *openapi3.T, baseUrl string,
+) (jsonHandler, yamlHandler gin.HandlerFunc, err error) {
+	swagger.Servers = openapi3.Servers{
+		&openapi3.Server{URL: strings.TrimRight(baseUrl, "/")},
+	}
+	jsonDoc, err := swagger.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+	yamlDoc, err := yaml.Marshal(swagger)
+	if err != nil {
+		return nil, nil, err
+	}
+	jsonHandler = func(gc *gin.Context) {
+		gc.Data(http.StatusOK, "application/json", jsonDoc)
+	}
+	yamlHandler = func(gc *gin.Context) {
+		gc.Data(http.StatusOK, "application/yaml", yamlDoc)
+	}
+	return jsonHandler, yamlHandler, nil
+}
+
+// docsHandler serves the interactive API documentation.
+func docsHandler(gc *gin.Context) {
+	gc.Data(http.StatusOK, "text/html; charset=utf-8", []byte(docsPage))
+}
+
+// docsAssetHandler serves the embedded asset of the docs page.
+func docsAssetHandler(gc *gin.Context) {
+	gc.FileFromFS(gc.Request.URL.Path, http.FS(docsAssets))
+}
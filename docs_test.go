@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_openapi_json_serves_the_rebased_spec(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/openapi.json", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+	swagger, err := openapi3.NewLoader().LoadFromData(res.Body.Bytes())
+	assert.Nil(t, err)
+	assert.Len(t, swagger.Servers, 1)
+	assert.Equal(t, "http://localhost", swagger.Servers[0].URL)
+	assert.NotNil(t, swagger.Paths.Value(schema.BaseUri+"/{id}"))
+	assert.Nil(t, swagger.Paths.Value("/{id}"))
+}
+
+func Test_openapi_yaml_serves_the_spec(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/openapi.yaml", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "application/yaml", res.Header().Get("Content-Type"))
+	var doc struct {
+		OpenAPI string           `yaml:"openapi"`
+		Servers []map[string]any `yaml:"servers"`
+		Paths   map[string]any   `yaml:"paths"`
+	}
+	assert.Nil(t, yaml.Unmarshal(res.Body.Bytes(), &doc))
+	assert.NotEmpty(t, doc.OpenAPI)
+	assert.Equal(t, "http://localhost", doc.Servers[0]["url"])
+	assert.Contains(t, doc.Paths, schema.BaseUri+"/{id}/children")
+}
+
+func Test_specHandlers_trims_trailing_slash_of_base_url(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.Nil(t, err)
+	_, _, err = specHandlers(swagger, "https://example.com/")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://example.com", swagger.Servers[0].URL)
+}
+
+func Test_docs_are_served_only_if_enabled(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/docs", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	t.Setenv("DOCS_ENABLED", "true")
+	_, engine, _, _ = setupGinTest(t)
+	res = serveRequest(engine, http.MethodGet, "/docs", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Contains(t, res.Body.String(), `url: "openapi.json"`)
+	assert.NotContains(t, res.Body.String(), "https://")
+}
+
+func Test_docs_assets_are_served_locally(t *testing.T) {
+	t.Setenv("DOCS_ENABLED", "true")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/docs/swagger-ui-bundle.js", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Contains(t, res.Header().Get("Content-Type"), "javascript")
+	assert.Contains(t, res.Body.String(), "SwaggerUIBundle")
+	res = serveRequest(engine, http.MethodGet, "/docs/swagger-ui.css", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Contains(t, res.Header().Get("Content-Type"), "text/css")
+}
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+)
+
+// GrantTableName is the name of the grant table in the database.
+const GrantTableName = "grants"
+
+// Permissions of grants, each one includes those before it.
+const (
+	PermissionRead  = "read"
+	PermissionWrite = "write"
+	// PermissionAdmin also allows managing grants of the subtree.
+	PermissionAdmin = "admin"
+)
+
+// Grant gives a subject permission on an item, which is inherited by all
+// descendants of the item.
+type Grant struct {
+	ent.Schema
+}
+
+func (Grant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     GrantTableName,
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Item grant table"),
+		// grants are managed via the grant endpoints of items
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (Grant) Fields() []ent.Field {
+	u1 := uint64(1)
+	u255 := uint64(255)
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Unique().Immutable().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+						Maximum: ogen.Num("4294967295"),
+					},
+				),
+			),
+			field.Uint32("item_id").Immutable().
+				Comment("ID of the item granted").
+				Annotations(uint32Schema("ID of the item granted")),
+			field.String("subject").Immutable().NotEmpty().MaxLen(255).
+				Comment("Subject granted, as authenticated").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:        "string",
+						MinLength:   &u1,
+						MaxLength:   &u255,
+						Description: "Subject granted, as authenticated",
+					},
+				),
+			),
+			field.Enum("permission").
+				Values(PermissionRead, PermissionWrite, PermissionAdmin).
+				Comment("Permission granted"),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+func (Grant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "subject").Unique(),
+		index.Fields("subject"),
+	}
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// HistoryTableName is the name of the item history table in the database.
+const HistoryTableName = "item_histories"
+
+// Actions recorded in the item history table.
+const (
+	HistoryCreate  = "create"
+	HistoryUpdate  = "update"
+	HistoryDelete  = "delete"
+	HistoryRestore = "restore"
+	HistoryPurge   = "purge"
+	HistoryRevert  = "revert"
+)
+
+// ItemHistory is an append-only log of changes made to items.
+type ItemHistory struct {
+	ent.Schema
+}
+
+func (ItemHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     HistoryTableName,
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Item change history table"),
+		// history is exposed via the `/{id}/history` endpoint only
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (ItemHistory) Fields() []ent.Field {
+	action := []string{
+		HistoryCreate, HistoryUpdate, HistoryDelete, HistoryRestore,
+		HistoryPurge, HistoryRevert,
+	}
+	return []ent.Field{
+		field.Uint64("id").Unique().Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint32("item_id").Immutable().Comment("ID of the changed item").
+			Annotations(uint32Schema("ID of the changed item")),
+		field.Uint32("revision").Immutable().
+			Comment("Revision number of the item, starting from 1").
+			Annotations(uint32Schema("Revision number of the item, starting from 1")),
+		field.Enum("action").Values(action...).Immutable().
+			Comment("The kind of change"),
+		field.String("name_before").Optional().Nillable().Immutable().
+			Comment("Item name before the change"),
+		field.String("name_after").Optional().Nillable().Immutable().
+			Comment("Item name after the change"),
+		field.Uint32("parent_before").Optional().Nillable().Immutable().
+			Comment("Parent ID before the change").
+			Annotations(uint32Schema("Parent ID before the change")),
+		field.Uint32("parent_after").Optional().Nillable().Immutable().
+			Comment("Parent ID after the change").
+			Annotations(uint32Schema("Parent ID after the change")),
+		field.String("actor").Optional().Nillable().Immutable().
+			Comment("Who made the change"),
+		field.String("request_id").Optional().Nillable().Immutable().
+			Comment("ID of the request that made the change"),
+		field.Time("created_at").Default(time.Now).Immutable().
+			Comment("When the change was made"),
+	}
+}
+
+// uint32Schema adds constraints to the generated OpenAPI specification.
+func uint32Schema(description string) schema.Annotation {
+	return entoas.Schema(
+		&ogen.Schema{
+			Type:        "integer",
+			Format:      "uint32",
+			Minimum:     ogen.Num("1"),
+			Maximum:     ogen.Num("4294967295"),
+			Description: description,
+		},
+	)
+}
+
+func (ItemHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "revision").Unique(),
+		index.Fields("created_at"),
+	}
+}
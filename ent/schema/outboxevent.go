@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxTableName is the name of the outbox table in the database.
+const OutboxTableName = "outbox_events"
+
+// OutboxEvent is an item event waiting to be published. It is written in the
+// same transaction as the change of the item, so no event is lost.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     OutboxTableName,
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Transactional outbox of item events"),
+		// the outbox is internal to the service
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Immutable(),
+		field.String("event").Immutable().Comment("Type of the event"),
+		field.Text("payload").Immutable().Comment("JSON payload of the event"),
+		field.String("request_id").Optional().Nillable().Immutable().
+			Comment("ID of the request that caused the event"),
+		field.Time("created_at").Default(time.Now).Immutable().
+			Comment("When the event happened"),
+		field.Time("published_at").Optional().Nillable().
+			Comment("When the event was published, null if pending"),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at"),
+	}
+}
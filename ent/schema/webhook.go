@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"fmt"
+	"net/url"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+)
+
+// WebhookTableName is the name of the webhook table in the database.
+const WebhookTableName = "webhooks"
+
+// WebhookEvents are the item events that webhooks can subscribe to.
+var WebhookEvents = []string{"create", "update", "move", "delete", "restore"}
+
+// Webhook is a subscription to item events, delivered to the given URL.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     WebhookTableName,
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Webhook subscription table"),
+	}
+}
+
+func (Webhook) Fields() []ent.Field {
+	u1 := uint64(1)
+	u16 := uint64(16)
+	u255 := uint64(255)
+	u2048 := uint64(2048)
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Unique().Immutable().Annotations(
+				// adds constraints to the generated OpenAPI specification
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+						Maximum: ogen.Num("4294967295"),
+					},
+				),
+			),
+			field.String("url").NotEmpty().MaxLen(2048).Validate(validateUrl).
+				Comment("URL receiving the events").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:        "string",
+						Format:      "uri",
+						MinLength:   &u1,
+						MaxLength:   &u2048,
+						Description: "URL receiving the events",
+					},
+				),
+			),
+			field.String("secret").Sensitive().NotEmpty().MinLen(16).
+				MaxLen(255).Comment("Secret used to sign the payloads").
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:        "string",
+							MinLength:   &u16,
+							MaxLength:   &u255,
+							Description: "Secret used to sign the payloads",
+						},
+					),
+				),
+			field.Strings("events").Optional().
+				Comment("Events to deliver, all events if empty").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:  "array",
+						Items: &ogen.Items{Item: &ogen.Schema{Type: "string"}},
+						Description: "Events to deliver, any of `create`, " +
+							"`update`, `move`, `delete` and `restore`. " +
+							"All events are delivered if empty",
+					},
+				),
+			),
+			field.Bool("active").Default(true).StructTag(`json:"active"`).
+				Comment("Whether events are delivered to the webhook"),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+func validateUrl(s string) error {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return err
+	}
+	if ("http" != u.Scheme && "https" != u.Scheme) || "" == u.Host {
+		return fmt.Errorf("webhook URL must be an absolute HTTP(S) URL")
+	}
+	return nil
+}
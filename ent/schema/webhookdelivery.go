@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// WebhookDeliveryTableName is the name of the webhook delivery table in the
+// database.
+const WebhookDeliveryTableName = "webhook_deliveries"
+
+// Statuses of webhook deliveries.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	// DeliveryDead marks deliveries that failed after all attempts.
+	DeliveryDead = "dead"
+)
+
+// WebhookDelivery is an item event to be delivered to a webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     WebhookDeliveryTableName,
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_unicode_ci",
+		},
+		entsql.WithComments(true),
+		schema.Comment("Webhook delivery table"),
+		// dead deliveries are exposed via the dead letter endpoint only
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint32("webhook_id").Immutable().
+			Comment("ID of the webhook to deliver to").
+			Annotations(uint32Schema("ID of the webhook to deliver to")),
+		field.Uint64("event_id").Immutable().Comment("ID of the event").
+			Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:        "integer",
+						Format:      "uint64",
+						Minimum:     ogen.Num("1"),
+						Description: "ID of the event",
+					},
+				),
+			),
+		field.String("event").Immutable().Comment("Type of the event"),
+		field.Text("payload").Immutable().Comment("JSON payload to deliver"),
+		field.Enum("status").
+			Values(DeliveryPending, DeliveryDelivered, DeliveryDead).
+			Default(DeliveryPending).Comment("Status of the delivery"),
+		field.Uint32("attempts").Default(0).
+			Comment("Number of delivery attempts made"),
+		field.Time("next_attempt_at").Default(time.Now).
+			Comment("When to make the next attempt"),
+		field.Text("last_error").Optional().Nillable().
+			Comment("Error of the last failed attempt"),
+		field.Time("created_at").Default(time.Now).Immutable().
+			Comment("When the event happened"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).
+			Comment("When the delivery was last attempted"),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "status"),
+		// events relayed more than once are delivered only once
+		index.Fields("webhook_id", "event_id").Unique(),
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-simple-tree/ent"
+)
+
+// statusClientClosedRequest is the non-standard status of requests canceled
+// by their clients, popularized by nginx. Clients never see it, but it keeps
+// disconnects apart from server errors in logs and metrics.
+const statusClientClosedRequest = 499
+
+// errNotFound is wrapped by errors of resources not found, other than ent
+// queries not finding any row, e.g. items without parent.
+var errNotFound = errors.New("not found")
+
+// cycleError is returned for changes that would make an item its own
+// ancestor, by moving it under one of its descendants.
+type cycleError struct {
+	id, parentId uint32
+}
+
+func (e cycleError) Error() string {
+	return fmt.Sprintf("item %d is a descendant of item %d", e.parentId, e.id)
+}
+
+// ApiError is an error answered with its status code, and a body conforming
+// to the `N4xx` and `N500` schemas of the spec, or the `Problem` schema if
+// RFC 7807 problem details are wanted.
+type ApiError struct {
+	Code   int         `json:"code"`
+	Status string      `json:"status"`
+	Errors interface{} `json:"errors,omitempty"`
+	// problem is the type of problem details, defaults to the type of the
+	// status code.
+	problem string
+	// err is the original error, which is logged but not sent to clients.
+	err error
+}
+
+func newApiError(code int, err error, errs interface{}) *ApiError {
+	status := http.StatusText(code)
+	if statusClientClosedRequest == code {
+		status = "Client Closed Request"
+	}
+	return &ApiError{
+		Code:    code,
+		Status:  status,
+		Errors:  errs,
+		problem: problemOfStatus[code],
+		err:     err,
+	}
+}
+
+func (e *ApiError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ApiError) Unwrap() error {
+	return e.err
+}
+
+// toApiError maps the error to the response it is answered with. Details of
+// server errors are not disclosed.
+func toApiError(err error) *ApiError {
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	var invalid *ent.ValidationError
+	switch {
+	case errors.As(err, &invalid):
+		msg := invalid.Error()
+		if nil != errors.Unwrap(invalid) {
+			msg = errors.Unwrap(invalid).Error()
+		}
+		apiErr = newApiError(
+			http.StatusUnprocessableEntity, err,
+			[]FieldError{{Field: invalid.Name, Message: msg}},
+		)
+		if errors.As(err, &cycleError{}) {
+			apiErr.problem = problemCycle
+		}
+		return apiErr
+	case errors.Is(err, errForbidden):
+		return newApiError(http.StatusForbidden, err, err.Error())
+	case ent.IsNotFound(err):
+		return newApiError(
+			http.StatusNotFound, err, strings.TrimPrefix(err.Error(), "ent: "),
+		)
+	case errors.Is(err, errNotReadable), errors.Is(err, errNotFound):
+		return newApiError(http.StatusNotFound, err, err.Error())
+	case ent.IsConstraintError(err):
+		return newApiError(
+			http.StatusConflict, err, "request conflicts with existing data",
+		)
+	case errors.Is(err, context.DeadlineExceeded):
+		return newApiError(http.StatusGatewayTimeout, err, "request timed out")
+	case errors.Is(err, context.Canceled):
+		return newApiError(statusClientClosedRequest, err, "request canceled")
+	}
+	return newApiError(
+		http.StatusInternalServerError, err,
+		http.StatusText(http.StatusInternalServerError),
+	)
+}
+
+const (
+	// errorFormatJson answers errors with the `N4xx` and `N500` schemas.
+	errorFormatJson = "json"
+	// errorFormatProblem answers errors with RFC 7807 problem details.
+	errorFormatProblem = "problem"
+
+	// problemTypeBase is prefixed to problem types to make their URIs, which
+	// lead to their documentation.
+	problemTypeBase = "https://github.com/eidng8/go-simple-tree#"
+
+	// problemCycle is the problem type of changes that would make an item its
+	// own ancestor.
+	problemCycle = "cycle"
+
+	mimeProblem = "application/problem+json"
+
+	problemKey = "problem"
+)
+
+// problemOfStatus maps status codes to the types of their problem details.
+var problemOfStatus = map[int]string{
+	http.StatusBadRequest:            "validation-failed",
+	http.StatusUnauthorized:          "unauthorized",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not-found",
+	http.StatusConflict:              "conflict",
+	http.StatusRequestEntityTooLarge: "payload-too-large",
+	http.StatusUnprocessableEntity:   "invalid-change",
+	http.StatusTooManyRequests:       "rate-limited",
+	statusClientClosedRequest:        "canceled",
+	http.StatusInternalServerError:   "internal-error",
+	http.StatusGatewayTimeout:        "timeout",
+}
+
+// problemTitles are summaries of problem types.
+var problemTitles = map[string]string{
+	"validation-failed": "Request failed validation",
+	"unauthorized":      "Missing or invalid credentials",
+	"forbidden":         "Permission denied",
+	"not-found":         "Resource not found",
+	"conflict":          "Request conflicts with existing data",
+	"payload-too-large": "Request body too large",
+	"invalid-change":    "Change cannot be made",
+	problemCycle:        "Change would make an item its own ancestor",
+	"rate-limited":      "Rate limit exceeded",
+	"canceled":          "Request canceled by the client",
+	"internal-error":    "Internal server error",
+	"timeout":           "Request timed out",
+}
+
+// ProblemDetails is the RFC 7807 body of errors, described by the `Problem`
+// schema of the spec.
+type ProblemDetails struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam is a parameter or property of the request failing
+// validation.
+type InvalidParam struct {
+	Name   string `json:"name,omitempty"`
+	In     string `json:"in,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// problemDetails returns the problem details of the error, occurred at the
+// given request path.
+func (e *ApiError) problemDetails(path string) ProblemDetails {
+	problem := e.problem
+	if "" == problem {
+		problem = problemOfStatus[http.StatusInternalServerError]
+	}
+	pd := ProblemDetails{
+		Type:     problemTypeBase + problem,
+		Title:    problemTitles[problem],
+		Status:   e.Code,
+		Instance: path,
+	}
+	switch errs := e.Errors.(type) {
+	case string:
+		pd.Detail = errs
+	case []FieldError:
+		pd.InvalidParams = make([]InvalidParam, len(errs))
+		for i, fe := range errs {
+			pd.InvalidParams[i] = InvalidParam{
+				Name: fe.Field, In: fe.In, Reason: fe.Message,
+			}
+		}
+		if 1 == len(errs) {
+			pd.Detail = errs[0].Message
+		} else {
+			pd.Detail = fmt.Sprintf("%d parameters are invalid", len(errs))
+		}
+	}
+	return pd
+}
+
+// errorFormatMiddleware decides the format of error responses. RFC 7807
+// problem details are answered if the format is `problem`, or if requested by
+// the `Accept` header.
+func errorFormatMiddleware(format string) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		problem := errorFormatProblem == format
+		if !problem {
+			for _, accept := range gc.Request.Header.Values("Accept") {
+				for _, mime := range strings.Split(accept, ",") {
+					mime, _, _ = strings.Cut(mime, ";")
+					if mimeProblem == strings.TrimSpace(mime) {
+						problem = true
+					}
+				}
+			}
+		}
+		gc.Set(problemKey, problem)
+		gc.Next()
+	}
+}
+
+// abortWithError answers the request with the error, in the format decided
+// by errorFormatMiddleware.
+func abortWithError(gc *gin.Context, apiErr *ApiError) {
+	gc.Header("Vary", "Accept")
+	if !gc.GetBool(problemKey) {
+		gc.AbortWithStatusJSON(apiErr.Code, apiErr)
+		return
+	}
+	gc.Header("Content-Type", mimeProblem)
+	gc.AbortWithStatusJSON(
+		apiErr.Code, apiErr.problemDetails(gc.Request.URL.Path),
+	)
+}
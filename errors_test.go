@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_toApiError_maps_errors_to_status(t *testing.T) {
+	_, _, entClient, _ := setupGinTest(t)
+	ctx := context.Background()
+	_, notFound := entClient.Item.Get(ctx, 1000)
+	entClient.Grant.Create().SetItemID(1).SetSubject("eve").
+		SetPermission(grant.PermissionRead).ExecX(ctx)
+	conflict := entClient.Grant.Create().SetItemID(1).SetSubject("eve").
+		SetPermission(grant.PermissionRead).Exec(ctx)
+	tests := []struct {
+		err    error
+		code   int
+		errors interface{}
+	}{
+		{
+			ent.NewValidationError("name", errors.New("too short")),
+			http.StatusUnprocessableEntity,
+			[]FieldError{{Field: "name", Message: "too short"}},
+		},
+		{errForbidden, http.StatusForbidden, "permission denied"},
+		{notFound, http.StatusNotFound, "item not found"},
+		{errNotReadable, http.StatusNotFound, "item not found"},
+		{
+			fmt.Errorf("item 1 has no parent: %w", errNotFound),
+			http.StatusNotFound, "item 1 has no parent: not found",
+		},
+		{
+			conflict, http.StatusConflict,
+			"request conflicts with existing data",
+		},
+		{
+			fmt.Errorf("query: %w", context.DeadlineExceeded),
+			http.StatusGatewayTimeout, "request timed out",
+		},
+		{
+			errors.New("secret details"), http.StatusInternalServerError,
+			"Internal Server Error",
+		},
+	}
+	for _, test := range tests {
+		actual := toApiError(test.err)
+		assert.Equal(t, test.code, actual.Code, test.err.Error())
+		assert.Equal(t, http.StatusText(test.code), actual.Status)
+		assert.Equal(t, test.errors, actual.Errors, test.err.Error())
+		assert.ErrorIs(t, actual, test.err)
+	}
+}
+
+func Test_toApiError_tells_canceled_requests_from_server_errors(t *testing.T) {
+	actual := toApiError(fmt.Errorf("query: %w", context.Canceled))
+	assert.Equal(t, statusClientClosedRequest, actual.Code)
+	assert.Equal(t, "Client Closed Request", actual.Status)
+	assert.Equal(t, "request canceled", actual.Errors)
+	assert.Equal(t, problemTypeBase+"canceled", actual.problemDetails("").Type)
+}
+
+func Test_toApiError_keeps_api_errors(t *testing.T) {
+	err := newApiError(http.StatusBadRequest, errors.New("bad"), "bad")
+	assert.Same(t, err, toApiError(fmt.Errorf("wrapped: %w", err)))
+}
+
+func Test_handlers_answer_not_found_with_body(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/1000", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	assert.JSONEq(
+		t, `{"code":404,"status":"Not Found","errors":"item not found"}`,
+		res.Body.String(),
+	)
+	res = serveRequest(engine, http.MethodGet, schema.BaseUri+"/1/parent", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	assert.JSONEq(
+		t,
+		`{"code":404,"status":"Not Found","errors":"item 1 has no parent: not found"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_handlers_answer_validation_errors_with_fields(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/1", `{"parent_id":1}`,
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	assert.JSONEq(
+		t,
+		`{"code":422,"status":"Unprocessable Entity","errors":[{"field":"parent_id","message":"ParentId cannot be equal to self"}]}`,
+		res.Body.String(),
+	)
+}
+
+func Test_handlers_hide_details_of_server_errors(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	assert.Nil(t, entClient.Close())
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/1", "")
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+	assert.JSONEq(
+		t,
+		`{"code":500,"status":"Internal Server Error","errors":"Internal Server Error"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_problem_details_are_answered_if_accepted(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1000", "",
+		withHeader("Accept", "application/problem+json"),
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	assert.Equal(
+		t, "application/problem+json", res.Header().Get("Content-Type"),
+	)
+	assert.Equal(t, "Accept", res.Header().Get("Vary"))
+	assert.JSONEq(
+		t, `{
+			"type": "https://github.com/eidng8/go-simple-tree#not-found",
+			"title": "Resource not found",
+			"status": 404,
+			"detail": "item not found",
+			"instance": "/simple-tree/1000"
+		}`,
+		res.Body.String(),
+	)
+}
+
+func Test_problem_details_list_invalid_params(t *testing.T) {
+	t.Setenv("ERROR_FORMAT", "problem")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"a","extra":1}`,
+	)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Equal(
+		t, "application/problem+json", res.Header().Get("Content-Type"),
+	)
+	var actual ProblemDetails
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(
+		t, "https://github.com/eidng8/go-simple-tree#validation-failed",
+		actual.Type,
+	)
+	assert.Equal(t, "2 parameters are invalid", actual.Detail)
+	assert.ElementsMatch(
+		t, []InvalidParam{
+			{Name: "name", In: "body", Reason: "minimum string length is 2"},
+			{In: "body", Reason: `property "extra" is unsupported`},
+		},
+		actual.InvalidParams,
+	)
+}
+
+func Test_problem_details_of_middleware_errors(t *testing.T) {
+	t.Setenv("AUTH_JWT_KEY_FILE", writeTestFile(t, "key", testHmacKey))
+	t.Setenv("ERROR_FORMAT", "problem")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/1", "")
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	var actual ProblemDetails
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(
+		t, "https://github.com/eidng8/go-simple-tree#unauthorized",
+		actual.Type,
+	)
+	assert.Equal(t, http.StatusUnauthorized, actual.Status)
+}
+
+func Test_problem_details_tell_cycles_apart(t *testing.T) {
+	err := toApiError(
+		ent.NewValidationError("parent_id", cycleError{id: 2, parentId: 3}),
+	)
+	pd := err.problemDetails("/simple-tree/2")
+	assert.Equal(t, http.StatusUnprocessableEntity, pd.Status)
+	assert.Equal(t, "https://github.com/eidng8/go-simple-tree#cycle", pd.Type)
+	assert.Equal(t, "item 3 is a descendant of item 2", pd.Detail)
+	assert.Equal(
+		t, []InvalidParam{
+			{Name: "parent_id", Reason: "item 3 is a descendant of item 2"},
+		},
+		pd.InvalidParams,
+	)
+}
+
+func Test_ERROR_FORMAT_must_be_known(t *testing.T) {
+	setTestEnv(t)
+	t.Setenv("ERROR_FORMAT", "xml")
+	_, err := loadConfig(nil)
+	assert.ErrorContains(t, err, "ERROR_FORMAT")
+}
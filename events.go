@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/eidng8/go-simple-tree/ent"
+)
+
+// Types of item change events.
+const (
+	EventCreate  = "create"
+	EventUpdate  = "update"
+	EventMove    = "move"
+	EventDelete  = "delete"
+	EventRestore = "restore"
+	// EventReset tells the client that events after its `Last-Event-ID` are no
+	// longer in the log, so it has to reload its data.
+	EventReset = "reset"
+)
+
+// defaultEventLogSize is the number of events kept for resuming streams.
+const defaultEventLogSize = 1000
+
+// publishedIdCount is the number of recently published event IDs remembered,
+// to ignore events relayed again.
+const publishedIdCount = 1000
+
+// eventBufferSize is the number of events buffered for each subscriber. Slow
+// subscribers falling behind are disconnected, to resume from the event log.
+const eventBufferSize = 64
+
+// ItemEventData is the payload of item change events.
+type ItemEventData struct {
+	Id       uint32  `json:"id"`
+	ParentId *uint32 `json:"parent_id,omitempty"`
+	Name     string  `json:"name,omitempty"`
+	// PreviousParentId is the parent of the item before it was moved.
+	PreviousParentId *uint32 `json:"previous_parent_id,omitempty"`
+	// Purged is true if the item was deleted permanently.
+	Purged bool `json:"purged,omitempty"`
+}
+
+func newItemEventData(item *ent.Item) ItemEventData {
+	return ItemEventData{Id: item.ID, ParentId: item.ParentID, Name: item.Name}
+}
+
+// Event is an item change event. Its ID is the ID of the event in the outbox.
+type Event struct {
+	Id   uint64        `json:"id"`
+	Type string        `json:"event"`
+	Time time.Time     `json:"time"`
+	Data ItemEventData `json:"data"`
+	// RequestId is the ID of the request that caused the event.
+	RequestId string `json:"request_id,omitempty"`
+	// readers are subjects who could read the item when the event was
+	// published to the event log.
+	readers []string
+}
+
+// Encode returns the event in the Server-Sent Events wire format.
+func (e Event) Encode() ([]byte, error) {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	return fmt.Appendf(
+		nil, "id: %d\nevent: %s\ndata: %s\n\n", e.Id, e.Type, data,
+	), nil
+}
+
+// EventLog fans out item change events to subscribers, and keeps a bounded
+// log of recent events for subscribers resuming from a `Last-Event-ID`.
+//
+// Events are kept in the order they are published, which isn't necessarily the
+// order of their IDs. IDs are allocated when events are written to the outbox,
+// but transactions may commit in a different order.
+type EventLog struct {
+	mu   sync.Mutex
+	size int
+	// lastId is the ID of the event published last.
+	lastId uint64
+	events []Event
+	// published are IDs of recently published events, in publish order.
+	published   []uint64
+	seen        map[uint64]struct{}
+	subscribers map[chan Event]struct{}
+	// Readers resolves subjects who can read the given item, once for each
+	// event, so that subscribers can filter events without querying. Nil if
+	// all events are visible to everyone.
+	Readers func(ctx context.Context, id uint32) ([]string, error)
+}
+
+// NewEventLog returns an event log keeping at most `size` recent events.
+func NewEventLog(size int) *EventLog {
+	return &EventLog{
+		size:        size,
+		events:      make([]Event, 0, size),
+		published:   make([]uint64, 0, publishedIdCount),
+		seen:        make(map[uint64]struct{}, publishedIdCount),
+		subscribers: make(map[chan Event]struct{}),
+	}
+}
+
+// Publish appends an event to the log and sends it to all subscribers. Events
+// recently published are ignored.
+func (l *EventLog) Publish(ctx context.Context, event Event) error {
+	if nil != l.Readers {
+		var err error
+		event.readers, err = l.Readers(ctx, event.Data.Id)
+		if err != nil {
+			return err
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.seen[event.Id]; ok {
+		return nil
+	}
+	if len(l.published) == publishedIdCount {
+		delete(l.seen, l.published[0])
+		copy(l.published, l.published[1:])
+		l.published = l.published[:publishedIdCount-1]
+	}
+	l.published = append(l.published, event.Id)
+	l.seen[event.Id] = struct{}{}
+	l.lastId = event.Id
+	if len(l.events) == l.size {
+		copy(l.events, l.events[1:])
+		l.events = l.events[:l.size-1]
+	}
+	if l.size > 0 {
+		l.events = append(l.events, event)
+	}
+	for ch := range l.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(l.subscribers, ch)
+			close(ch)
+		}
+	}
+	return nil
+}
+
+// Subscribe registers a new subscriber, returning events in the log published
+// after the event of `lastId`, along with the channel of subsequent events. The
+// returned bool is false if the event of `lastId` is no longer in the log, or
+// was published before the server restarted, in which case all events in the
+// log are returned. The channel is closed when the subscriber falls behind.
+func (l *EventLog) Subscribe(lastId uint64) ([]Event, chan Event, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ch := make(chan Event, eventBufferSize)
+	l.subscribers[ch] = struct{}{}
+	if 0 == lastId || lastId == l.lastId {
+		return nil, ch, true
+	}
+	for i, event := range l.events {
+		if event.Id == lastId {
+			return slices.Clone(l.events[i+1:]), ch, true
+		}
+	}
+	return slices.Clone(l.events), ch, false
+}
+
+// Unsubscribe removes the subscriber.
+func (l *EventLog) Unsubscribe(ch chan Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.subscribers[ch]; ok {
+		delete(l.subscribers, ch)
+		close(ch)
+	}
+}
+
+// DisconnectAll removes all subscribers, closing their channels. Streams end
+// as if their subscribers fell behind, so clients reconnect.
+func (l *EventLog) DisconnectAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.subscribers {
+		delete(l.subscribers, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+)
+
+// itemView is the shape of items read, decided by the `fields` and `expand`
+// query parameters.
+type itemView struct {
+	// fields are the fields included besides `id` and `name`, nil for all.
+	fields                      map[ItemField]bool
+	parent, children, ancestors bool
+}
+
+func newItemView(fields *[]ItemField, expand *[]ItemEdge) itemView {
+	v := itemView{}
+	if nil != fields {
+		v.fields = make(map[ItemField]bool, len(*fields))
+		for _, f := range *fields {
+			v.fields[f] = true
+		}
+	}
+	if nil != expand {
+		v.parent = slices.Contains(*expand, Parent)
+		v.children = slices.Contains(*expand, Children)
+		v.ancestors = slices.Contains(*expand, Ancestors)
+	}
+	return v
+}
+
+func (v itemView) has(f ItemField) bool {
+	return nil == v.fields || v.fields[f]
+}
+
+// checkAsOf returns an error if edges are to be expanded in reads of the
+// past, which are reconstructed from history without edges.
+func (v itemView) checkAsOf(asOf *time.Time) error {
+	if nil == asOf || !(v.parent || v.children || v.ancestors) {
+		return nil
+	}
+	return newApiError(
+		http.StatusBadRequest, errors.New("expand is not supported with as_of"),
+		[]FieldError{
+			{In: "query", Field: "expand", Message: "not supported with as_of"},
+		},
+	)
+}
+
+// eagerLoad adds eager loading of the edges to expand to the query. Parents
+// the principal cannot read are left out, while children of readable items
+// are always readable.
+func (s Server) eagerLoad(
+	ctx context.Context, query *ent.ItemQuery, v itemView,
+) {
+	if v.parent {
+		query.WithParent(
+			func(q *ent.ItemQuery) { q.Where(s.readable(ctx, item.FieldID)) },
+		)
+	}
+	if v.children {
+		query.WithChildren(func(q *ent.ItemQuery) { q.Order(item.ByID()) })
+	}
+}
+
+// ancestorsOf returns ancestors of the items, root first, keyed by item ID.
+// Ancestors the principal cannot read, or trashed ones unless `qc` includes
+// trashed items, are left out.
+func (s Server) ancestorsOf(
+	qc context.Context, rows []*ent.Item,
+) (map[uint32][]*ent.Item, error) {
+	chains, err := s.ancestorChains(qc, rows)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint32
+	for _, chain := range chains {
+		for _, row := range chain {
+			ids = append(ids, row.ID)
+		}
+	}
+	if 0 == len(ids) {
+		return chains, nil
+	}
+	visible, err := s.EC.Item.Query().
+		Where(item.IDIn(ids...), s.readable(qc, item.FieldID)).IDs(qc)
+	if err != nil {
+		return nil, err
+	}
+	for id, chain := range chains {
+		chains[id] = slices.DeleteFunc(
+			chain, func(row *ent.Item) bool {
+				return !slices.Contains(visible, row.ID)
+			},
+		)
+	}
+	return chains, nil
+}
+
+// ancestorChains returns all ancestors of the items, root first, keyed by
+// item ID. Ancestors of all items are fetched level by level, walking through
+// trashed ones.
+func (s Server) ancestorChains(
+	ctx context.Context, rows []*ent.Item,
+) (map[uint32][]*ent.Item, error) {
+	known := make(map[uint32]*ent.Item, len(rows))
+	for _, row := range rows {
+		known[row.ID] = row
+	}
+	tc := softdelete.IncludeTrashed(ctx)
+	for ids := unknownParents(rows, known); len(ids) > 0; {
+		parents, err := s.EC.Item.Query().Where(item.IDIn(ids...)).All(tc)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range parents {
+			known[row.ID] = row
+		}
+		ids = unknownParents(parents, known)
+	}
+	chains := make(map[uint32][]*ent.Item, len(rows))
+	for _, row := range rows {
+		var chain []*ent.Item
+		visited := map[uint32]bool{row.ID: true}
+		for pid := row.ParentID; nil != pid && !visited[*pid]; {
+			parent, ok := known[*pid]
+			if !ok {
+				break
+			}
+			visited[parent.ID] = true
+			chain = append(chain, parent)
+			pid = parent.ParentID
+		}
+		slices.Reverse(chain)
+		chains[row.ID] = chain
+	}
+	return chains, nil
+}
+
+// unknownParents returns IDs of parents of the items not known yet.
+func unknownParents(rows []*ent.Item, known map[uint32]*ent.Item) []uint32 {
+	var ids []uint32
+	for _, row := range rows {
+		pid := row.ParentID
+		if nil != pid && nil == known[*pid] && !slices.Contains(ids, *pid) {
+			ids = append(ids, *pid)
+		}
+	}
+	return ids
+}
+
+// mask leaves out fields not in the view.
+func (v itemView) mask(aa ItemList) ItemList {
+	if !v.has(ParentId) {
+		aa.ParentId = nil
+	}
+	if !v.has(CreatedAt) {
+		aa.CreatedAt = nil
+	}
+	if !v.has(UpdatedAt) {
+		aa.UpdatedAt = nil
+	}
+	if !v.has(DeletedAt) {
+		aa.DeletedAt = nil
+	}
+	return aa
+}
+
+func (v itemView) maskAll(rows []*ent.Item) []ItemList {
+	data := make([]ItemList, len(rows))
+	for i, row := range rows {
+		data[i] = v.mask(newItemListFromEnt(row))
+	}
+	return data
+}
+
+// itemList returns the item in the view. Edges to expand must have been
+// loaded, by eagerLoad and ancestorsOf.
+func (v itemView) itemList(
+	row *ent.Item, ancestors map[uint32][]*ent.Item,
+) ItemList {
+	aa := v.mask(newItemListFromEnt(row))
+	if v.parent && nil != row.Edges.Parent {
+		parent := v.mask(newItemListFromEnt(row.Edges.Parent))
+		aa.Parent = &parent
+	}
+	if v.children {
+		children := v.maskAll(row.Edges.Children)
+		aa.Children = &children
+	}
+	if v.ancestors {
+		list := v.maskAll(ancestors[row.ID])
+		aa.Ancestors = &list
+	}
+	return aa
+}
+
+// newItemPage returns the page of items in the view.
+func (s Server) newItemPage(
+	qc context.Context, v itemView, page *paginate.PaginatedList[ent.Item],
+) (*paginate.PaginatedList[ItemList], error) {
+	var ancestors map[uint32][]*ent.Item
+	if v.ancestors {
+		var err error
+		ancestors, err = s.ancestorsOf(qc, page.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	data := make([]*ItemList, len(page.Data))
+	for i, row := range page.Data {
+		aa := v.itemList(row, ancestors)
+		data[i] = &aa
+	}
+	return &paginate.PaginatedList[ItemList]{
+		Total:        page.Total,
+		PerPage:      page.PerPage,
+		CurrentPage:  page.CurrentPage,
+		LastPage:     page.LastPage,
+		FirstPageUrl: page.FirstPageUrl,
+		LastPageUrl:  page.LastPageUrl,
+		NextPageUrl:  page.NextPageUrl,
+		PrevPageUrl:  page.PrevPageUrl,
+		Path:         page.Path,
+		From:         page.From,
+		To:           page.To,
+		Data:         data,
+	}, nil
+}
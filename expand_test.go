@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/grant"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// expandTree makes item 2 child of item 1, items 3 & 4 children of item 2,
+// and item 5 child of item 3.
+var expandTree = map[uint32]uint32{2: 1, 3: 2, 4: 2, 5: 3}
+
+func Test_ReadItem_returns_requested_fields_only(t *testing.T) {
+	engine, _ := setupTree(t, expandTree)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2?fields=parent_id", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(
+		t, `{"id":2,"name":"name 1","parent_id":1}`, res.Body.String(),
+	)
+}
+
+func Test_ReadItem_expands_edges(t *testing.T) {
+	engine, _ := setupTree(t, expandTree)
+	res := serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/3?fields=id&expand=parent,children,ancestors", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(
+		t, `{
+			"id": 3,
+			"name": "name 2",
+			"parent": {"id":2,"name":"name 1"},
+			"children": [{"id":5,"name":"name 4"}],
+			"ancestors": [{"id":1,"name":"name 0"},{"id":2,"name":"name 1"}]
+		}`,
+		res.Body.String(),
+	)
+}
+
+func Test_ReadItem_expands_empty_edges_of_roots(t *testing.T) {
+	engine, _ := setupTree(t, expandTree)
+	res := serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/10?fields=id&expand=parent,children,ancestors", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(
+		t, `{"id":10,"name":"name 9","children":[],"ancestors":[]}`,
+		res.Body.String(),
+	)
+}
+
+func Test_ListItem_expands_edges_of_each_item(t *testing.T) {
+	engine, _ := setupTree(t, expandTree)
+	res := serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/2/children?fields=id&expand=parent,ancestors", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	var page ListItemChildren200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	assert.Len(t, page.Data, 2)
+	for _, row := range page.Data {
+		assert.Nil(t, row.ParentId)
+		assert.Nil(t, row.CreatedAt)
+		assert.Equal(t, uint32(2), row.Parent.Id)
+		assert.Equal(
+			t, []ItemList{{Id: 1, Name: "name 0"}, {Id: 2, Name: "name 1"}},
+			*row.Ancestors,
+		)
+	}
+	res = serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/1/children?recurse=1&fields=id&expand=children", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	children := map[uint32]int{}
+	for _, row := range page.Data {
+		children[row.Id] = len(*row.Children)
+	}
+	assert.Equal(t, map[uint32]int{2: 2, 3: 1, 4: 0, 5: 0}, children)
+}
+
+func Test_expand_leaves_out_unreadable_ancestors(t *testing.T) {
+	_, engine, entClient := setupAuthzTest(t)
+	entClient.Grant.Create().SetItemID(4).SetSubject("eve").
+		SetPermission(grant.PermissionRead).SaveX(context.Background())
+	res := serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/4?fields=id&expand=parent,ancestors", "",
+		withHeader(HeaderApiKey, "eve-key"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(
+		t, `{"id":4,"name":"name 3","ancestors":[]}`, res.Body.String(),
+	)
+	res = serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/4?fields=id&expand=parent,ancestors", "",
+		withHeader(HeaderApiKey, "bob-key"),
+	)
+	assert.JSONEq(
+		t, `{
+			"id": 4,
+			"name": "name 3",
+			"parent": {"id":2,"name":"name 1"},
+			"ancestors": [{"id":1,"name":"name 0"},{"id":2,"name":"name 1"}]
+		}`,
+		res.Body.String(),
+	)
+}
+
+func Test_expand_reports_400_with_as_of(t *testing.T) {
+	engine, _ := setupTree(t, expandTree)
+	asOf := url.QueryEscape(time.Now().UTC().Format(time.RFC3339))
+	for _, uri := range []string{"", "/1", "/1/children"} {
+		res := serveRequest(
+			engine, http.MethodGet,
+			schema.BaseUri+uri+"?expand=parent&as_of="+asOf, "",
+		)
+		assert.Equal(t, http.StatusBadRequest, res.Code, uri)
+		assert.JSONEq(
+			t,
+			`{"code":400,"status":"Bad Request","errors":[{"in":"query","field":"expand","message":"not supported with as_of"}]}`,
+			res.Body.String(),
+		)
+	}
+}
+
+func Test_fields_apply_to_snapshots(t *testing.T) {
+	engine, entClient := setupTree(t, expandTree)
+	backdateHistory(t, entClient, time.Hour)
+	asOf := url.QueryEscape(
+		time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+	)
+	res := serveRequest(
+		engine, http.MethodGet,
+		schema.BaseUri+"/2?fields=parent_id&as_of="+asOf, "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(
+		t, `{"id":2,"name":"name 1","parent_id":1}`, res.Body.String(),
+	)
+}
+
+func Test_fields_must_be_known(t *testing.T) {
+	engine, _ := setupTree(t, expandTree)
+	for _, query := range []string{"fields=size", "expand=siblings"} {
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+"/1?"+query, "",
+		)
+		assert.Equal(t, http.StatusBadRequest, res.Code, query)
+	}
+}
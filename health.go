@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-simple-tree/ent"
+)
+
+// readyCheckTimeout limits the time spent checking the database on each probe.
+const readyCheckTimeout = 2 * time.Second
+
+// healthzHandler answers `200` as long as the process is up.
+func healthzHandler(gc *gin.Context) {
+	gc.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// readyzHandler answers `200` if the server is ready to take requests, i.e.
+// migrations have been applied, the server is not shutting down, and the
+// database answers queries. Otherwise, it answers `503`.
+func readyzHandler(ec *ent.Client, ready *atomic.Bool) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		if !ready.Load() {
+			gc.JSON(
+				http.StatusServiceUnavailable,
+				gin.H{"status": "unavailable", "error": "not ready"},
+			)
+			return
+		}
+		ctx, cancel := context.WithTimeout(
+			gc.Request.Context(), readyCheckTimeout,
+		)
+		defer cancel()
+		// a query on the table also tells whether the schema is in place
+		if _, err := ec.Item.Query().Exist(ctx); err != nil {
+			loggerFromContext(ctx).Warn("readiness check failed", "error", err)
+			gc.JSON(
+				http.StatusServiceUnavailable,
+				gin.H{"status": "unavailable", "error": "database unavailable"},
+			)
+			return
+		}
+		gc.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
+
+// serve accepts connections until the context is done, then shuts the server
+// down gracefully. The server is reported not ready first, and keeps serving
+// for `delay`, giving load balancers time to stop sending new requests. Then
+// in-flight requests are given `timeout` to finish, after which their
+// connections are closed.
+func serve(
+	ctx context.Context, srv *http.Server, ready *atomic.Bool,
+	delay, timeout time.Duration,
+) error {
+	failed := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			failed <- err
+		}
+		close(failed)
+	}()
+	select {
+	case err := <-failed:
+		return err
+	case <-ctx.Done():
+	}
+	ready.Store(false)
+	slog.Info("Shutting down", "delay", delay, "timeout", timeout)
+	select {
+	case err := <-failed:
+		return err
+	case <-time.After(delay):
+	}
+	sc, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(sc); err != nil {
+		_ = srv.Close()
+		return err
+	}
+	return nil
+}
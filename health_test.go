@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// freeAddr returns a local address nothing listens on.
+func freeAddr(tb testing.TB) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(tb, err)
+	addr := l.Addr().String()
+	assert.Nil(tb, l.Close())
+	return addr
+}
+
+// startServer serves the handler until the returned context is canceled.
+// The returned channel receives the error returned by `serve`.
+func startServer(
+	tb testing.TB, handler http.Handler, ready *atomic.Bool,
+	delay, timeout time.Duration,
+) (*http.Server, context.CancelFunc, chan error) {
+	srv := &http.Server{Addr: freeAddr(tb), Handler: handler}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- serve(ctx, srv, ready, delay, timeout) }()
+	assert.Eventually(
+		tb, func() bool {
+			conn, err := net.Dial("tcp", srv.Addr)
+			if err != nil {
+				return false
+			}
+			_ = conn.Close()
+			return true
+		}, time.Second, time.Millisecond,
+	)
+	return srv, cancel, done
+}
+
+func Test_healthz_reports_ok(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/healthz", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, res.Body.String())
+}
+
+func Test_readyz_reports_readiness(t *testing.T) {
+	server, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/readyz", "")
+	assert.Equal(t, http.StatusServiceUnavailable, res.Code)
+	assert.JSONEq(
+		t, `{"status":"unavailable","error":"not ready"}`, res.Body.String(),
+	)
+	server.Ready.Store(true)
+	res = serveRequest(engine, http.MethodGet, "/readyz", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, res.Body.String())
+}
+
+func Test_readyz_reports_unavailable_without_schema(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, ":memory:")
+	assert.Nil(t, err)
+	entClient := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	defer func() { _ = entClient.Close() }()
+	ready := &atomic.Bool{}
+	ready.Store(true)
+	engine := gin.New()
+	engine.GET("/readyz", readyzHandler(entClient, ready))
+	res := serveRequest(engine, http.MethodGet, "/readyz", "")
+	assert.Equal(t, http.StatusServiceUnavailable, res.Code)
+	assert.JSONEq(
+		t, `{"status":"unavailable","error":"database unavailable"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_serve_drains_requests_on_shutdown(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	engine := gin.New()
+	engine.GET(
+		"/slow", func(gc *gin.Context) {
+			close(started)
+			<-release
+			gc.Status(http.StatusNoContent)
+		},
+	)
+	ready := &atomic.Bool{}
+	ready.Store(true)
+	srv, cancel, done := startServer(t, engine, ready, 0, time.Second)
+	finished := make(chan int, 1)
+	go func() {
+		res, err := http.Get("http://" + srv.Addr + "/slow")
+		assert.Nil(t, err)
+		_ = res.Body.Close()
+		finished <- res.StatusCode
+	}()
+	<-started
+	cancel()
+	assert.Eventually(
+		t, func() bool { return !ready.Load() }, time.Second, time.Millisecond,
+	)
+	// new connections are refused while draining
+	_, err := http.Get("http://" + srv.Addr + "/slow")
+	assert.NotNil(t, err)
+	close(release)
+	assert.Equal(t, http.StatusNoContent, <-finished)
+	assert.Nil(t, <-done)
+}
+
+func Test_serve_keeps_serving_during_shutdown_delay(t *testing.T) {
+	server, engine, _, _ := setupGinTest(t)
+	server.Ready.Store(true)
+	srv, cancel, done := startServer(
+		t, engine, server.Ready, 200*time.Millisecond, time.Second,
+	)
+	cancel()
+	assert.Eventually(
+		t, func() bool { return !server.Ready.Load() }, time.Second,
+		time.Millisecond,
+	)
+	res, err := http.Get("http://" + srv.Addr + "/readyz")
+	assert.Nil(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	res, err = http.Get("http://" + srv.Addr + schema.BaseUri + "/1")
+	assert.Nil(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	select {
+	case err = <-done:
+		assert.Fail(t, "shut down before the delay", err)
+	default:
+	}
+	assert.Nil(t, <-done)
+}
+
+func Test_serve_gives_up_draining_after_timeout(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	engine := gin.New()
+	engine.GET(
+		"/stuck", func(gc *gin.Context) {
+			close(started)
+			<-release
+		},
+	)
+	srv, cancel, done := startServer(
+		t, engine, &atomic.Bool{}, 0, 10*time.Millisecond,
+	)
+	go func() {
+		res, err := http.Get("http://" + srv.Addr + "/stuck")
+		if nil == err {
+			_ = res.Body.Close()
+		}
+	}()
+	<-started
+	cancel()
+	assert.ErrorIs(t, <-done, context.DeadlineExceeded)
+}
+
+func Test_serve_ends_event_streams_on_shutdown(t *testing.T) {
+	server, engine, _, _ := setupGinTest(t)
+	srv, cancel, done := startServer(t, engine, server.Ready, 0, time.Minute)
+	srv.RegisterOnShutdown(server.Events.DisconnectAll)
+	res, err := http.Get("http://" + srv.Addr + schema.BaseUri + "/events")
+	assert.Nil(t, err)
+	defer func() { _ = res.Body.Close() }()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	cancel()
+	select {
+	case err = <-done:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "event stream blocks shutdown")
+	}
+}
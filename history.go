@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/hook"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+	"github.com/eidng8/go-simple-tree/ent/predicate"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// useHooks registers hooks recording the item history to the client.
+func useHooks(ec *ent.Client) {
+	ec.Item.Use(historyHook())
+	// history is append-only
+	ec.ItemHistory.Use(
+		hook.Reject(
+			ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne,
+		),
+	)
+}
+
+type auditKey struct{}
+
+// Audit holds who made a change and in which request.
+type Audit struct {
+	Actor     string
+	RequestId string
+}
+
+// newAuditContext returns a new context carrying the given audit information,
+// which is recorded by the item history hook.
+func newAuditContext(parent context.Context, audit Audit) context.Context {
+	return context.WithValue(parent, auditKey{}, audit)
+}
+
+// auditFromContext returns the audit information carried by the context.
+func auditFromContext(ctx context.Context) Audit {
+	audit, _ := ctx.Value(auditKey{}).(Audit)
+	return audit
+}
+
+type revertKey struct{}
+
+// newRevertContext returns a new context marking mutations made with it as
+// reverts to a previous revision.
+func newRevertContext(parent context.Context) context.Context {
+	return context.WithValue(parent, revertKey{}, true)
+}
+
+func isRevert(ctx context.Context) bool {
+	revert, _ := ctx.Value(revertKey{}).(bool)
+	return revert
+}
+
+// lockForUpdate locks the selected rows until the transaction ends. SQLite
+// doesn't support row locks, and serializes writes to the whole database.
+func lockForUpdate(s *sql.Selector) {
+	if dialect.SQLite != s.Dialect() {
+		s.ForUpdate()
+	}
+}
+
+// historyHook records every mutation of items to the item history table,
+// within the same transaction as the mutation.
+func historyHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ItemFunc(
+			func(ctx context.Context, m *ent.ItemMutation) (ent.Value, error) {
+				if m.Op().Is(ent.OpCreate) {
+					v, err := next.Mutate(ctx, m)
+					if err != nil {
+						return nil, err
+					}
+					row := v.(*ent.Item)
+					err = writeHistory(
+						ctx, m.Client(), schema.HistoryCreate, row.ID, nil, row,
+					)
+					return v, err
+				}
+				ids, err := m.IDs(softdelete.IncludeTrashed(ctx))
+				if err != nil {
+					return nil, err
+				}
+				// locking the items serializes concurrent changes of them,
+				// so that revisions are numbered without gaps or duplicates
+				before, err := m.Client().Item.Query().
+					Where(item.IDIn(ids...), predicate.Item(lockForUpdate)).
+					All(softdelete.IncludeTrashed(ctx))
+				if err != nil {
+					return nil, err
+				}
+				deleting := m.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				if deleting && !m.Op().Is(ent.OpDelete|ent.OpDeleteOne) {
+					// soft deletion has turned the mutation into an update,
+					// which is recorded when the hook runs again for it
+					return v, nil
+				}
+				action := historyAction(ctx, m)
+				for _, old := range before {
+					var after *ent.Item
+					if schema.HistoryPurge != action {
+						after = applyMutation(old, m)
+					}
+					err = writeHistory(
+						ctx, m.Client(), action, old.ID, old, after,
+					)
+					if err != nil {
+						return nil, err
+					}
+				}
+				return v, nil
+			},
+		)
+	}
+}
+
+func historyAction(ctx context.Context, m *ent.ItemMutation) string {
+	switch {
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return schema.HistoryPurge
+	case m.DeletedAtCleared():
+		return schema.HistoryRestore
+	case isRevert(ctx):
+		return schema.HistoryRevert
+	}
+	if _, ok := m.DeletedAt(); ok {
+		return schema.HistoryDelete
+	}
+	return schema.HistoryUpdate
+}
+
+// applyMutation returns a copy of the given item with the name & parent
+// changes of the mutation applied.
+func applyMutation(old *ent.Item, m *ent.ItemMutation) *ent.Item {
+	after := *old
+	if name, ok := m.Name(); ok {
+		after.Name = name
+	}
+	if m.ParentIDCleared() {
+		after.ParentID = nil
+	} else if pid, ok := m.ParentID(); ok {
+		after.ParentID = &pid
+	}
+	return &after
+}
+
+func writeHistory(
+	ctx context.Context, client *ent.Client, action string, id uint32,
+	before, after *ent.Item,
+) error {
+	last, err := client.ItemHistory.Query().
+		Where(itemhistory.ItemID(id), predicate.ItemHistory(lockForUpdate)).
+		Order(itemhistory.ByRevision(sql.OrderDesc())).
+		First(ctx)
+	var revision uint32 = 1
+	if err == nil {
+		revision = last.Revision + 1
+	} else if !ent.IsNotFound(err) {
+		return err
+	}
+	audit := auditFromContext(ctx)
+	hc := client.ItemHistory.Create().
+		SetItemID(id).
+		SetRevision(revision).
+		SetAction(itemhistory.Action(action))
+	if before != nil {
+		hc.SetNameBefore(before.Name).SetNillableParentBefore(before.ParentID)
+	}
+	if after != nil {
+		hc.SetNameAfter(after.Name).SetNillableParentAfter(after.ParentID)
+	}
+	if audit.Actor != "" {
+		hc.SetActor(audit.Actor)
+	}
+	if audit.RequestId != "" {
+		hc.SetRequestID(audit.RequestId)
+	}
+	return hc.Exec(ctx)
+}
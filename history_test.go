@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/softdelete"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+)
+
+func Test_lockForUpdate_locks_rows_except_on_sqlite(t *testing.T) {
+	for _, d := range []string{dialect.MySQL, dialect.Postgres} {
+		s := sql.Dialect(d).Select().From(sql.Table("items"))
+		lockForUpdate(s)
+		query, _ := s.Query()
+		assert.Contains(t, query, "FOR UPDATE", d)
+	}
+	s := sql.Dialect(dialect.SQLite).Select().From(sql.Table("items"))
+	lockForUpdate(s)
+	query, _ := s.Query()
+	assert.NotContains(t, query, "FOR UPDATE")
+}
+
+func Test_historyHook_records_soft_deletion_and_purge_once(t *testing.T) {
+	_, _, entClient, _ := setupGinTest(t)
+	ctx := context.Background()
+	entClient.Item.DeleteOneID(50).ExecX(ctx)
+	entClient.Item.DeleteOneID(50).ExecX(softdelete.IncludeTrashed(ctx))
+	rows := entClient.ItemHistory.Query().Where(itemhistory.ItemID(50)).
+		Order(itemhistory.ByRevision()).AllX(ctx)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, itemhistory.ActionDelete, rows[1].Action)
+	assert.Equal(t, itemhistory.ActionPurge, rows[2].Action)
+	assert.Equal(t, uint32(3), rows[2].Revision)
+}
+
+func Test_useHooks_rejects_changing_history(t *testing.T) {
+	_, _, entClient, _ := setupGinTest(t)
+	ctx := context.Background()
+	_, err := entClient.ItemHistory.Update().Save(ctx)
+	assert.NotNil(t, err)
+	_, err = entClient.ItemHistory.Delete().Exec(ctx)
+	assert.NotNil(t, err)
+}
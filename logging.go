@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxRequestIdLength is the maximum length of request IDs sent by clients.
+const maxRequestIdLength = 128
+
+type requestIdKey struct{}
+
+// NewRequestIdContext returns a new context carrying the request ID.
+func NewRequestIdContext(parent context.Context, id string) context.Context {
+	return context.WithValue(parent, requestIdKey{}, id)
+}
+
+// RequestIdFromContext returns the ID of the request, or an empty string if
+// the context doesn't belong to any request.
+func RequestIdFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
+
+// requestIdMiddleware attaches the ID of the request to its context, and sends
+// it back in the `X-Request-ID` response header. The ID sent by the client is
+// used if valid, otherwise a new one is generated.
+func requestIdMiddleware(gc *gin.Context) {
+	id := gc.GetHeader(HeaderRequestId)
+	if !isValidRequestId(id) {
+		id = uuid.NewString()
+	}
+	gc.Header(HeaderRequestId, id)
+	gc.Request = gc.Request.WithContext(
+		NewRequestIdContext(gc.Request.Context(), id),
+	)
+	gc.Next()
+}
+
+// isValidRequestId returns true if the ID is not empty, not too long, and
+// consists of visible ASCII characters only.
+func isValidRequestId(id string) bool {
+	if "" == id || len(id) > maxRequestIdLength {
+		return false
+	}
+	for _, c := range []byte(id) {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// loggerFromContext returns the default logger, with the ID of the request and
+// the trace it belongs to, if any.
+func loggerFromContext(ctx context.Context) *slog.Logger {
+	logger := slog.Default()
+	if id := RequestIdFromContext(ctx); "" != id {
+		logger = logger.With("request_id", id)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		logger = logger.With("trace_id", sc.TraceID().String())
+	}
+	return logger
+}
+
+// accessLogMiddleware logs every request once it's finished.
+func accessLogMiddleware(gc *gin.Context) {
+	start := time.Now()
+	gc.Next()
+	loggerFromContext(gc.Request.Context()).Info(
+		"request",
+		"method", gc.Request.Method,
+		"path", gc.Request.URL.Path,
+		"route", routePath(gc),
+		"status", gc.Writer.Status(),
+		"bytes", gc.Writer.Size(),
+		"duration", time.Since(start),
+		"client_ip", gc.ClientIP(),
+	)
+}
+
+// recoveryMiddleware answers requests whose handler panics with `500`, in the
+// format of other errors, and logs the panic along with the stack trace.
+func recoveryMiddleware() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(
+		io.Discard, func(gc *gin.Context, err any) {
+			loggerFromContext(gc.Request.Context()).Error(
+				"panic", "error", fmt.Sprint(err),
+				"stack", string(debug.Stack()),
+			)
+			abortWithError(gc, toApiError(fmt.Errorf("panic: %v", err)))
+		},
+	)
+}
+
+// newLogger returns a logger writing to stdout, configured by the given
+// configuration.
+func newLogger(cfg LogConfig) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("LOG_LEVEL: %w", err)
+	}
+	opts := &slog.HandlerOptions{Level: level}
+	switch format := strings.ToLower(cfg.Format); format {
+	case "json":
+		return slog.New(slog.NewJSONHandler(os.Stdout, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(os.Stdout, opts)), nil
+	default:
+		return nil, fmt.Errorf("LOG_FORMAT: unknown format %q", format)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+	"github.com/eidng8/go-simple-tree/ent/webhookdelivery"
+)
+
+// captureLogs makes the default logger write JSON lines to the returned
+// buffer until the test finishes.
+func captureLogs(tb testing.TB) *bytes.Buffer {
+	logger := slog.Default()
+	buf := &bytes.Buffer{}
+	slog.SetDefault(slog.New(slog.NewJSONHandler(buf, nil)))
+	tb.Cleanup(func() { slog.SetDefault(logger) })
+	return buf
+}
+
+// logRecords returns all records in the buffer having the given message.
+func logRecords(tb testing.TB, buf *bytes.Buffer, msg string) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		assert.Nil(tb, json.Unmarshal([]byte(line), &record))
+		if msg == record["msg"] {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func Test_requestIdMiddleware_echoes_valid_id(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1", "",
+		withHeader(HeaderRequestId, "abc-123"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "abc-123", res.Header().Get(HeaderRequestId))
+}
+
+func Test_requestIdMiddleware_replaces_missing_or_invalid_id(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/1", "")
+	assert.Len(t, res.Header().Get(HeaderRequestId), 36)
+	for _, id := range []string{"has space", "ünicode", strings.Repeat("a", 129)} {
+		res = serveRequest(
+			engine, http.MethodGet, schema.BaseUri+"/1", "",
+			withHeader(HeaderRequestId, id),
+		)
+		assert.Len(t, res.Header().Get(HeaderRequestId), 36, id)
+	}
+}
+
+func Test_accessLogMiddleware_logs_requests_with_id(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	buf := captureLogs(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/1", "",
+		withHeader(HeaderRequestId, "abc-123"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	records := logRecords(t, buf, "request")
+	assert.Len(t, records, 1)
+	assert.Equal(t, "INFO", records[0]["level"])
+	assert.Equal(t, "abc-123", records[0]["request_id"])
+	assert.Equal(t, http.MethodGet, records[0]["method"])
+	assert.Equal(t, schema.BaseUri+"/:id", records[0]["route"])
+	assert.Equal(t, float64(http.StatusOK), records[0]["status"])
+}
+
+func Test_handleErrorResponse_logs_errors_with_request_id(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	buf := captureLogs(t)
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/1", `{"parent_id":1}`,
+		withHeader(HeaderRequestId, "abc-123"),
+	)
+	assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	records := logRecords(t, buf, "handler error")
+	assert.Len(t, records, 1)
+	assert.Equal(t, "INFO", records[0]["level"])
+	assert.Equal(t, "abc-123", records[0]["request_id"])
+	assert.Equal(t, schema.BaseUri+"/:id", records[0]["route"])
+	assert.Equal(
+		t, float64(http.StatusUnprocessableEntity), records[0]["status"],
+	)
+	assert.Contains(t, records[0]["error"], "ParentId")
+}
+
+func Test_recoveryMiddleware_logs_panics(t *testing.T) {
+	buf := captureLogs(t)
+	engine := gin.New()
+	engine.Use(requestIdMiddleware, recoveryMiddleware())
+	engine.GET("/panic", func(*gin.Context) { panic("boom") })
+	res := serveRequest(
+		engine, http.MethodGet, "/panic", "",
+		withHeader(HeaderRequestId, "abc-123"),
+	)
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+	assert.JSONEq(
+		t,
+		`{"code":500,"status":"Internal Server Error","errors":"Internal Server Error"}`,
+		res.Body.String(),
+	)
+	records := logRecords(t, buf, "panic")
+	assert.Len(t, records, 1)
+	assert.Equal(t, "ERROR", records[0]["level"])
+	assert.Equal(t, "abc-123", records[0]["request_id"])
+	assert.Equal(t, "boom", records[0]["error"])
+	assert.NotEmpty(t, records[0]["stack"])
+}
+
+func Test_recoveryMiddleware_answers_problem_details(t *testing.T) {
+	captureLogs(t)
+	engine := gin.New()
+	engine.Use(recoveryMiddleware(), errorFormatMiddleware(errorFormatJson))
+	engine.GET("/panic", func(*gin.Context) { panic("boom") })
+	res := serveRequest(
+		engine, http.MethodGet, "/panic", "",
+		withHeader("Accept", mimeProblem),
+	)
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+	assert.Equal(t, mimeProblem, res.Header().Get("Content-Type"))
+	assert.Contains(t, res.Body.String(), problemTypeBase+"internal-error")
+	assert.NotContains(t, res.Body.String(), "boom")
+}
+
+func Test_events_carry_request_id(t *testing.T) {
+	server, engine, entClient, _ := setupGinTest(t)
+	receiver := newWebhookReceiver(t, 0)
+	createTestWebhook(t, entClient, receiver.URL)
+	sink := &failingSink{}
+	d := newTestDispatcher(entClient)
+	server.Outbox.Sinks = []EventSink{sink, d}
+	res := serveRequest(
+		engine, http.MethodDelete, schema.BaseUri+"/2", "",
+		withHeader(HeaderRequestId, "abc-123"),
+	)
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	ctx := context.Background()
+	assert.Nil(t, server.Outbox.RelayPending(ctx))
+	assert.Len(t, sink.events, 1)
+	assert.Equal(t, "abc-123", sink.events[0].RequestId)
+	delivery := entClient.WebhookDelivery.Query().
+		Where(webhookdelivery.EventID(sink.events[0].Id)).OnlyX(ctx)
+	var payload WebhookPayload
+	assert.Nil(t, json.Unmarshal([]byte(delivery.Payload), &payload))
+	assert.Equal(t, "abc-123", payload.RequestId)
+}
+
+func Test_newLogger_rejects_invalid_config(t *testing.T) {
+	_, err := newLogger(LogConfig{Level: "loud", Format: "json"})
+	assert.ErrorContains(t, err, "LOG_LEVEL")
+	_, err = newLogger(LogConfig{Level: "debug", Format: "xml"})
+	assert.ErrorContains(t, err, "LOG_FORMAT")
+	logger, err := newLogger(LogConfig{Level: "debug", Format: "text"})
+	assert.Nil(t, err)
+	assert.True(t, logger.Enabled(context.Background(), slog.LevelDebug))
+}
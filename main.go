@@ -2,11 +2,22 @@ package main
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"errors"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/eidng8/go-db"
 	"github.com/gin-gonic/gin"
+	"github.com/go-sql-driver/mysql"
 
 	"github.com/eidng8/go-simple-tree/ent"
 	"github.com/eidng8/go-simple-tree/ent/migrate"
@@ -14,24 +25,92 @@ import (
 )
 
 func main() {
-	entClient := getEntClient()
-	_, engine, err := newEngine(entClient)
+	cfg, err := loadConfig(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fatal("Invalid configuration", err)
+	}
+	logger, err := newLogger(cfg.Log)
+	if err != nil {
+		fatal("Failed to setup logging", err)
+	}
+	slog.SetDefault(logger)
+	shutdownTracing, err := setupTracing(context.Background(), cfg.Tracing)
+	if err != nil {
+		fatal("Failed to setup tracing", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
+		}
+	}()
+	entClient, err := getEntClient(cfg)
 	if err != nil {
-		log.Fatalf("Failed to create server: %s", err)
+		fatal("Failed to connect to the database", err)
+	}
+	server, engine, err := newEngine(entClient, cfg)
+	if err != nil {
+		fatal("Failed to create server", err)
 	}
 	defer func(entClient *ent.Client) {
 		err := entClient.Close()
 		if err != nil {
-			log.Fatalf("Failed to close ent client: %s", err)
+			fatal("Failed to close ent client", err)
 		}
 	}(entClient)
 	err = setup(engine, entClient)
 	if err != nil {
-		log.Fatalf("Failed to setup server: %s", err)
+		fatal("Failed to setup server", err)
 	}
-	if err = engine.Run(getEnvWithDefault("LISTEN", ":80")); err != nil {
-		log.Fatalf("Server exits due to fatal error: %s", err)
+	dispatcher := newWebhookDispatcher(entClient, cfg.Webhooks)
+	server.Outbox.Sinks = append(server.Outbox.Sinks, dispatcher)
+	if path := cfg.Events.LogFile; "" != path {
+		sink, err := NewFileSink(path)
+		if err != nil {
+			fatal("Failed to open event log file", err)
+		}
+		defer func() { _ = sink.Close() }()
+		server.Outbox.Sinks = append(server.Outbox.Sinks, sink)
 	}
+	ctx, stop := signal.NotifyContext(
+		context.Background(), syscall.SIGINT, syscall.SIGTERM,
+	)
+	defer stop()
+	background, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		dispatcher.Run(background)
+	}()
+	go func() {
+		defer wg.Done()
+		server.Outbox.Run(background)
+	}()
+	srv := &http.Server{
+		Addr:    cfg.Listen,
+		Handler: engine,
+	}
+	// event streams never finish by themselves
+	srv.RegisterOnShutdown(server.Events.DisconnectAll)
+	server.Ready.Store(true)
+	err = serve(
+		ctx, srv, server.Ready, cfg.ShutdownDelay, cfg.ShutdownTimeout,
+	)
+	// background work stops only after in-flight requests are drained
+	cancel()
+	wg.Wait()
+	if err != nil {
+		fatal("Server exits due to fatal error", err)
+	}
+}
+
+// fatal logs the error and exits, like `log.Fatal`.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
 
 func setup(gc *gin.Engine, ec *ent.Client) error {
@@ -44,6 +123,44 @@ func setup(gc *gin.Engine, ec *ent.Client) error {
 	)
 }
 
-func getEntClient() *ent.Client {
-	return ent.NewClient(ent.Driver(entsql.OpenDB(db.ConnectX())))
+func getEntClient(cfg *Config) (*ent.Client, error) {
+	conn, err := openDB(cfg.DB)
+	if err != nil {
+		return nil, err
+	}
+	var drv dialect.Driver = &tracingDriver{
+		Driver: entsql.OpenDB(cfg.DB.Driver, conn),
+	}
+	if cfg.Metrics.Enabled {
+		drv = &metricsDriver{Driver: drv}
+	}
+	ec := ent.NewClient(ent.Driver(drv))
+	useHooks(ec)
+	return ec, nil
+}
+
+// openDB opens the configured database, by its DSN if set, or else by the
+// MySQL specific fields.
+func openDB(cfg DBConfig) (*sql.DB, error) {
+	if "" != cfg.DSN {
+		return sql.Open(cfg.Driver, cfg.DSN)
+	}
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	return db.ConnectMysql(
+		&mysql.Config{
+			User:                 cfg.User,
+			Passwd:               cfg.Password,
+			Net:                  cfg.Protocol,
+			Addr:                 cfg.Host,
+			DBName:               cfg.Name,
+			Collation:            cfg.Collation,
+			Loc:                  location,
+			AllowNativePasswords: true,
+			MultiStatements:      true,
+			ParseTime:            true,
+		},
+	)
 }
@@ -2,11 +2,20 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"io"
+	"maps"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
@@ -14,30 +23,103 @@ import (
 
 	"github.com/eidng8/go-simple-tree/ent"
 	"github.com/eidng8/go-simple-tree/ent/enttest"
+	"github.com/eidng8/go-simple-tree/ent/item"
+	"github.com/eidng8/go-simple-tree/ent/schema"
 )
 
-func setupGinTest(tb testing.TB) (
-	*Server, *gin.Engine, *ent.Client, *httptest.ResponseRecorder,
-) {
+// setTestEnv sets environment variables required by the configuration.
+func setTestEnv(tb testing.TB) {
 	assert.Nil(tb, os.Setenv("BASE_URL", "http://localhost"))
+	assert.Nil(tb, os.Setenv("DB_DRIVER", dialect.SQLite))
+	assert.Nil(tb, os.Setenv("DB_DSN", ":memory:?_fk=1"))
 	// assert.Nil(tb, os.Setenv("DB_DRIVER", "mysql"))
 	// assert.Nil(tb, os.Setenv("DB_USER", "root"))
 	// assert.Nil(tb, os.Setenv("DB_PASSWORD", "123456"))
 	// assert.Nil(tb, os.Setenv("DB_HOST", "127.0.0.1:43306"))
 	// assert.Nil(tb, os.Setenv("DB_NAME", "simple_tree"))
-	entClient := enttest.Open(tb, "sqlite3", ":memory:?_fk=1")
+}
+
+// testConfig loads the configuration from environment variables set by the
+// test.
+func testConfig(tb testing.TB) *Config {
+	setTestEnv(tb)
+	cfg, err := loadConfig(nil)
+	assert.Nil(tb, err)
+	return cfg
+}
+
+func setupGinTest(tb testing.TB) (
+	*Server, *gin.Engine, *ent.Client, *httptest.ResponseRecorder,
+) {
+	cfg := testConfig(tb)
+	// every connection to `:memory:` opens a new database, so background
+	// goroutines have to share the only connection with the test
+	db, err := sql.Open(dialect.SQLite, ":memory:?_fk=1")
+	assert.Nil(tb, err)
+	db.SetMaxOpenConns(1)
+	entClient := enttest.NewClient(
+		tb, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))),
+	)
 	tb.Cleanup(
 		func() {
 			_ = entClient.Close()
 		},
 	)
-	server, engine, err := newEngine(entClient)
+	useHooks(entClient)
+	server, engine, err := newEngine(entClient, cfg)
 	assert.Nil(tb, err)
 	assert.Nil(tb, setup(engine, entClient))
 	fixture(entClient)
 	return server, engine, entClient, httptest.NewRecorder()
 }
 
+// setupTree sets up the test like setupGinTest, then moves each item in
+// `parents` under its parent, and trashes the `trashed` items.
+func setupTree(
+	tb testing.TB, parents map[uint32]uint32, trashed ...uint32,
+) (*gin.Engine, *ent.Client) {
+	_, engine, entClient, _ := setupGinTest(tb)
+	ctx := context.Background()
+	for _, id := range slices.Sorted(maps.Keys(parents)) {
+		entClient.Item.UpdateOneID(id).SetParentID(parents[id]).ExecX(ctx)
+	}
+	if len(trashed) > 0 {
+		entClient.Item.Delete().Where(item.IDIn(trashed...)).ExecX(ctx)
+	}
+	return engine, entClient
+}
+
+// requestOption modifies a request before it is served.
+type requestOption func(*http.Request)
+
+// withHeader sets the given header of the request.
+func withHeader(name, value string) requestOption {
+	return func(req *http.Request) {
+		req.Header.Set(name, value)
+	}
+}
+
+// serveRequest serves the request through the engine and returns the recorded
+// response. A non-empty body is sent as JSON.
+func serveRequest(
+	engine http.Handler, method, url, body string, options ...requestOption,
+) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if "" != body {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, url, reader)
+	if "" != body {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, option := range options {
+		option(req)
+	}
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
 func fixture(client *ent.Client) {
 	ctx := context.Background()
 	items := make([]*ent.ItemCreate, 50)
@@ -46,3 +128,15 @@ func fixture(client *ent.Client) {
 	}
 	client.Item.CreateBulk(items...).SaveX(ctx)
 }
+
+// backdateHistory moves all recorded item changes back by the given duration.
+func backdateHistory(tb testing.TB, client *ent.Client, d time.Duration) {
+	_, err := client.ExecContext(
+		context.Background(),
+		fmt.Sprintf(
+			"UPDATE `%s` SET `created_at` = ?", schema.HistoryTableName,
+		),
+		time.Now().Add(-d),
+	)
+	assert.Nil(tb, err)
+}
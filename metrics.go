@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+)
+
+const metricsNamespace = "simple_tree"
+
+// metricsScrapeTimeout limits the time spent counting items on each scrape.
+const metricsScrapeTimeout = 5 * time.Second
+
+// unmatchedOperation labels requests not routed to any operation.
+const unmatchedOperation = "unmatched"
+
+var (
+	requestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_requests_total",
+			Help:      "Number of requests by operation and status code.",
+		},
+		[]string{"operation", "code"},
+	)
+	requestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of requests by operation.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"operation"},
+	)
+	dbQueryDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Duration of database statements by method.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+)
+
+// newMetricsRegistry returns a registry of all metrics of the service, with
+// gauges of the tree counted by the given client on each scrape.
+func newMetricsRegistry(ec *ent.Client) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requestsTotal, requestDuration, dbQueryDuration,
+		newTreeCollector(ec),
+	)
+	return registry
+}
+
+// metricsHandler serves metrics of the registry in Prometheus format.
+func metricsHandler(registry *prometheus.Registry) gin.HandlerFunc {
+	return gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+}
+
+// metricsMiddleware counts requests and records their latency, labeled by the
+// name of the `StrictServerInterface` operation they are routed to.
+// Operations are looked up by method and route, so requests rejected before
+// reaching their handler are labeled as well.
+func metricsMiddleware(operations map[string]string) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		start := time.Now()
+		gc.Next()
+		op, ok := operations[gc.Request.Method+" "+routePath(gc)]
+		if !ok {
+			op = unmatchedOperation
+		}
+		code := strconv.Itoa(gc.Writer.Status())
+		requestsTotal.WithLabelValues(op, code).Inc()
+		requestDuration.WithLabelValues(op).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+// operationNames maps routes of the spec, as `<method> <gin path>`, to names
+// of their `StrictServerInterface` operations.
+func operationNames(swagger *openapi3.T) map[string]string {
+	names := map[string]string{}
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if "" == op.OperationID {
+				continue
+			}
+			names[method+" "+ginPath(path)] = strings.ToUpper(op.OperationID[:1]) +
+				op.OperationID[1:]
+		}
+	}
+	return names
+}
+
+// treeCollector reports the size of the tree, counted on each scrape.
+type treeCollector struct {
+	ec          *ent.Client
+	items       *prometheus.Desc
+	roots       *prometheus.Desc
+	softDeleted *prometheus.Desc
+}
+
+func newTreeCollector(ec *ent.Client) *treeCollector {
+	return &treeCollector{
+		ec: ec,
+		items: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "items"),
+			"Number of items in the tree, excluding deleted ones.", nil, nil,
+		),
+		roots: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "root_items"),
+			"Number of root items, excluding deleted ones.", nil, nil,
+		),
+		softDeleted: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "soft_deleted_rows"),
+			"Number of soft deleted rows by table.", []string{"table"}, nil,
+		),
+	}
+}
+
+func (c *treeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.items
+	ch <- c.roots
+	ch <- c.softDeleted
+}
+
+func (c *treeCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), metricsScrapeTimeout,
+	)
+	defer cancel()
+	gauge := func(desc *prometheus.Desc, n int, err error, labels ...string) {
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(desc, err)
+			return
+		}
+		ch <- prometheus.MustNewConstMetric(
+			desc, prometheus.GaugeValue, float64(n), labels...,
+		)
+	}
+	n, err := c.ec.Item.Query().Count(ctx)
+	gauge(c.items, n, err)
+	n, err = c.ec.Item.Query().Where(item.ParentIDIsNil()).Count(ctx)
+	gauge(c.roots, n, err)
+	n, err = c.ec.Item.Query().Where(item.DeletedAtNotNil()).
+		Count(softdelete.IncludeTrashed(ctx))
+	gauge(c.softDeleted, n, err, item.Table)
+}
+
+// metricsDriver records durations of statements executed by the driver.
+type metricsDriver struct {
+	dialect.Driver
+}
+
+func (d *metricsDriver) Exec(ctx context.Context, query string, args, v any) error {
+	defer observeQuery("exec", time.Now())
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *metricsDriver) Query(ctx context.Context, query string, args, v any) error {
+	defer observeQuery("query", time.Now())
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *metricsDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsTx{Tx: tx}, nil
+}
+
+// metricsTx records durations of statements executed in the transaction.
+type metricsTx struct {
+	dialect.Tx
+}
+
+func (t *metricsTx) Exec(ctx context.Context, query string, args, v any) error {
+	defer observeQuery("exec", time.Now())
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+func (t *metricsTx) Query(ctx context.Context, query string, args, v any) error {
+	defer observeQuery("query", time.Now())
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+func observeQuery(method string, start time.Time) {
+	dbQueryDuration.WithLabelValues(method).
+		Observe(time.Since(start).Seconds())
+}
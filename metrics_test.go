@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/enttest"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_metrics_reports_requests_and_tree_size(t *testing.T) {
+	t.Setenv("METRICS_ENABLED", "true")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/1", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(engine, http.MethodDelete, schema.BaseUri+"/2", "")
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/nowhere", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/metrics", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+	body := res.Body.String()
+	assert.Contains(
+		t, body,
+		`simple_tree_http_requests_total{code="200",operation="ReadItem"}`,
+	)
+	assert.Contains(
+		t, body,
+		`simple_tree_http_requests_total{code="204",operation="DeleteItem"}`,
+	)
+	assert.Contains(
+		t, body,
+		`simple_tree_http_requests_total{code="404",operation="unmatched"}`,
+	)
+	assert.Contains(
+		t, body,
+		`simple_tree_http_request_duration_seconds_count{operation="ReadItem"}`,
+	)
+	assert.Contains(t, body, "\nsimple_tree_items 49\n")
+	assert.Contains(t, body, "\nsimple_tree_root_items 49\n")
+	assert.Contains(
+		t, body, "\nsimple_tree_soft_deleted_rows{table=\"items\"} 1\n",
+	)
+	assert.Contains(t, body, "\ngo_goroutines ")
+}
+
+func Test_metrics_is_disabled_by_default(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, "/metrics", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_operationNames_covers_all_operations(t *testing.T) {
+	swagger, err := GetSwagger()
+	assert.Nil(t, err)
+	names := operationNames(swagger)
+	assert.Equal(t, "ReadItem", names["GET /:id"])
+	ops := reflect.TypeOf((*StrictServerInterface)(nil)).Elem()
+	for i := range ops.NumMethod() {
+		name := ops.Method(i).Name
+		assert.True(
+			t, slices.Contains(slices.Collect(maps.Values(names)), name), name,
+		)
+	}
+}
+
+func Test_metricsDriver_records_query_durations(t *testing.T) {
+	db, err := sql.Open(dialect.SQLite, ":memory:?_fk=1")
+	assert.Nil(t, err)
+	db.SetMaxOpenConns(1)
+	entClient := enttest.NewClient(
+		t, enttest.WithOptions(
+			ent.Driver(&metricsDriver{Driver: entsql.OpenDB(dialect.SQLite, db)}),
+		),
+	)
+	defer func() { _ = entClient.Close() }()
+	fixture(entClient)
+	ctx := context.Background()
+	tx, err := entClient.Tx(ctx)
+	assert.Nil(t, err)
+	_, err = tx.Item.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Commit())
+	handler := promhttp.HandlerFor(
+		newMetricsRegistry(entClient), promhttp.HandlerOpts{},
+	)
+	res := serveRequest(handler, http.MethodGet, "/metrics", "")
+	assert.Contains(
+		t, res.Body.String(),
+		`simple_tree_db_query_duration_seconds_count{method="query"}`,
+	)
+	assert.Contains(
+		t, res.Body.String(),
+		`simple_tree_db_query_duration_seconds_count{method="exec"}`,
+	)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/outboxevent"
+)
+
+// outboxBatchSize is the maximum number of events relayed at a time.
+const outboxBatchSize = 100
+
+// EventSink publishes item events relayed from the outbox.
+type EventSink interface {
+	// Publish publishes the event. Events are relayed again if an error is
+	// returned, so sinks may receive the same event more than once.
+	Publish(ctx context.Context, event Event) error
+}
+
+// addEvent writes an item event to the outbox, within the same transaction as
+// the change of the item.
+func addEvent(
+	ctx context.Context, tx *ent.Tx, eventType string, data ItemEventData,
+) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	oc := tx.OutboxEvent.Create().
+		SetEvent(eventType).
+		SetPayload(string(payload))
+	if id := auditFromContext(ctx).RequestId; "" != id {
+		oc.SetRequestID(id)
+	}
+	return oc.Exec(ctx)
+}
+
+// addUpdateEvent writes a `move` event if the parent of the item has changed,
+// or an `update` event otherwise.
+func addUpdateEvent(
+	ctx context.Context, tx *ent.Tx, old, item *ent.Item,
+) error {
+	data := newItemEventData(item)
+	if equalParent(old.ParentID, item.ParentID) {
+		return addEvent(ctx, tx, EventUpdate, data)
+	}
+	data.PreviousParentId = old.ParentID
+	return addEvent(ctx, tx, EventMove, data)
+}
+
+func equalParent(a, b *uint32) bool {
+	if nil == a || nil == b {
+		return a == b
+	}
+	return *a == *b
+}
+
+func newEventFromOutbox(row *ent.OutboxEvent) (Event, error) {
+	event := Event{Id: row.ID, Type: row.Event, Time: row.CreatedAt}
+	if nil != row.RequestID {
+		event.RequestId = *row.RequestID
+	}
+	err := json.Unmarshal([]byte(row.Payload), &event.Data)
+	return event, err
+}
+
+// OutboxRelay publishes events in the outbox to all sinks in order, and marks
+// them as published afterward, which gives at-least-once delivery.
+type OutboxRelay struct {
+	EC    *ent.Client
+	Sinks []EventSink
+	// PollInterval is how often the outbox is checked without notification.
+	PollInterval time.Duration
+	// Retention is how long published events are kept in the outbox.
+	Retention time.Duration
+	wake      chan struct{}
+}
+
+func newOutboxRelay(
+	ec *ent.Client, retention time.Duration, sinks ...EventSink,
+) *OutboxRelay {
+	return &OutboxRelay{
+		EC:           ec,
+		Sinks:        sinks,
+		PollInterval: time.Second,
+		Retention:    retention,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// Notify wakes the relay up to publish newly committed events.
+func (r *OutboxRelay) Notify() {
+	if nil == r {
+		return
+	}
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run relays events until the context is done.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.PollInterval)
+	defer ticker.Stop()
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+	for {
+		if err := r.RelayPending(ctx); err != nil && nil == ctx.Err() {
+			slog.ErrorContext(ctx, "Failed to relay events", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.wake:
+		case <-ticker.C:
+		case <-prune.C:
+			if err := r.Prune(ctx); err != nil && nil == ctx.Err() {
+				slog.ErrorContext(
+					ctx, "Failed to prune the outbox", "error", err,
+				)
+			}
+		}
+	}
+}
+
+// RelayPending publishes all pending events. It stops at the first event
+// failing to publish, to keep events in order.
+func (r *OutboxRelay) RelayPending(ctx context.Context) error {
+	for {
+		rows, err := r.EC.OutboxEvent.Query().
+			Where(outboxevent.PublishedAtIsNil()).
+			Order(outboxevent.ByID()).
+			Limit(outboxBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			event, err := newEventFromOutbox(row)
+			if err != nil {
+				return err
+			}
+			for _, sink := range r.Sinks {
+				if err = sink.Publish(ctx, event); err != nil {
+					return err
+				}
+			}
+			err = r.EC.OutboxEvent.UpdateOne(row).
+				SetPublishedAt(time.Now()).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		if len(rows) < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+// Prune removes events published before the retention period.
+func (r *OutboxRelay) Prune(ctx context.Context) error {
+	_, err := r.EC.OutboxEvent.Delete().
+		Where(outboxevent.PublishedAtLT(time.Now().Add(-r.Retention))).
+		Exec(ctx)
+	return err
+}
+
+// FileSink appends events to a file as JSON lines.
+type FileSink struct {
+	file *os.File
+}
+
+// NewFileSink opens the file at the given path for appending events.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Publish(_ context.Context, event Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = s.file.Write(append(b, '\n'))
+	return err
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
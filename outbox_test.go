@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/outboxevent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// failingSink fails the given number of times before recording events.
+type failingSink struct {
+	failures int
+	events   []Event
+}
+
+func (s *failingSink) Publish(_ context.Context, event Event) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink failed")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func Test_OutboxRelay_relays_committed_events_in_order(t *testing.T) {
+	server, engine, entClient, _ := setupGinTest(t)
+	sink := &failingSink{}
+	server.Outbox.Sinks = []EventSink{sink}
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"new item"}`,
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	res = serveRequest(engine, http.MethodDelete, schema.BaseUri+"/2", "")
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	ctx := context.Background()
+	assert.Equal(
+		t, 2, entClient.OutboxEvent.Query().
+			Where(outboxevent.PublishedAtIsNil()).CountX(ctx),
+	)
+	assert.Nil(t, server.Outbox.RelayPending(ctx))
+	assert.Len(t, sink.events, 2)
+	assert.Equal(t, uint64(1), sink.events[0].Id)
+	assert.Equal(t, EventCreate, sink.events[0].Type)
+	assert.Equal(t, "new item", sink.events[0].Data.Name)
+	assert.Equal(t, uint64(2), sink.events[1].Id)
+	assert.Equal(t, EventDelete, sink.events[1].Type)
+	assert.Equal(t, uint32(2), sink.events[1].Data.Id)
+	assert.Equal(
+		t, 0, entClient.OutboxEvent.Query().
+			Where(outboxevent.PublishedAtIsNil()).CountX(ctx),
+	)
+}
+
+func Test_OutboxRelay_retries_events_failed_to_publish(t *testing.T) {
+	server, engine, entClient, _ := setupGinTest(t)
+	sink := &failingSink{failures: 1}
+	server.Outbox.Sinks = []EventSink{sink}
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/2", `{"name":"new name"}`,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	ctx := context.Background()
+	assert.NotNil(t, server.Outbox.RelayPending(ctx))
+	assert.Empty(t, sink.events)
+	assert.True(
+		t, entClient.OutboxEvent.Query().
+			Where(outboxevent.PublishedAtIsNil()).ExistX(ctx),
+	)
+	assert.Nil(t, server.Outbox.RelayPending(ctx))
+	assert.Len(t, sink.events, 1)
+	assert.Equal(t, EventUpdate, sink.events[0].Type)
+}
+
+func Test_OutboxRelay_relays_events_committed_out_of_id_order(t *testing.T) {
+	server, _, entClient, _ := setupGinTest(t)
+	ctx := context.Background()
+	backlog, ch, _ := server.Events.Subscribe(0)
+	assert.Empty(t, backlog)
+	// the transaction writing event 2 commits before the one writing event 1
+	entClient.OutboxEvent.Create().SetID(2).SetEvent(EventCreate).
+		SetPayload(`{"id":2}`).ExecX(ctx)
+	assert.Nil(t, server.Outbox.RelayPending(ctx))
+	entClient.OutboxEvent.Create().SetID(1).SetEvent(EventCreate).
+		SetPayload(`{"id":1}`).ExecX(ctx)
+	assert.Nil(t, server.Outbox.RelayPending(ctx))
+	assert.Equal(t, uint64(2), (<-ch).Id)
+	assert.Equal(t, uint64(1), (<-ch).Id)
+	// events relayed again are ignored
+	assert.Nil(t, server.Events.Publish(ctx, Event{Id: 2}))
+	assert.Empty(t, ch)
+	// resuming after event 2 still receives event 1
+	backlog, _, complete := server.Events.Subscribe(2)
+	assert.True(t, complete)
+	assert.Len(t, backlog, 1)
+	assert.Equal(t, uint64(1), backlog[0].Id)
+	backlog, _, complete = server.Events.Subscribe(1)
+	assert.True(t, complete)
+	assert.Empty(t, backlog)
+}
+
+func Test_OutboxRelay_prunes_published_events(t *testing.T) {
+	server, _, entClient, _ := setupGinTest(t)
+	ctx := context.Background()
+	entClient.OutboxEvent.Create().SetEvent(EventCreate).SetPayload(`{"id":1}`).
+		SetPublishedAt(time.Now().Add(-2 * server.Outbox.Retention)).
+		ExecX(ctx)
+	entClient.OutboxEvent.Create().SetEvent(EventCreate).SetPayload(`{"id":2}`).
+		SetPublishedAt(time.Now()).ExecX(ctx)
+	entClient.OutboxEvent.Create().SetEvent(EventCreate).SetPayload(`{"id":3}`).
+		ExecX(ctx)
+	assert.Nil(t, server.Outbox.Prune(ctx))
+	ids := entClient.OutboxEvent.Query().Order(outboxevent.ByID()).IDsX(ctx)
+	assert.Equal(t, []uint64{2, 3}, ids)
+}
+
+func Test_FileSink_appends_json_lines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewFileSink(path)
+	assert.Nil(t, err)
+	ctx := context.Background()
+	assert.Nil(t, sink.Publish(ctx, Event{Id: 1, Type: EventCreate}))
+	assert.Nil(t, sink.Publish(ctx, Event{Id: 2, Type: EventDelete}))
+	assert.Nil(t, sink.Close())
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = file.Close() })
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	assert.Len(t, events, 2)
+	assert.Equal(t, uint64(2), events[1].Id)
+	assert.Equal(t, EventDelete, events[1].Type)
+}
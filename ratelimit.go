@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// Classes of requests, each one limited by its own budget.
+const (
+	limitRead      = "read"
+	limitWrite     = "write"
+	limitRecursive = "recursive"
+)
+
+// defaultMaxBodySize is the default size limit of request bodies in bytes.
+const defaultMaxBodySize = 1 << 20
+
+// limiterSweepInterval is how often buckets of idle clients are dropped.
+const limiterSweepInterval = time.Minute
+
+// RateLimit is a token bucket budget, allowing bursts of Burst requests, and
+// refilled at Rate requests per second.
+type RateLimit struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+type bucketKey struct {
+	class  string
+	client string
+}
+
+// RateLimiter limits requests of each client, by one bucket per class of
+// requests. Classes without budget are not limited.
+type RateLimiter struct {
+	limits    map[string]RateLimit
+	mu        sync.Mutex
+	buckets   map[bucketKey]*rate.Limiter
+	lastSweep time.Time
+}
+
+// NewRateLimiter returns a limiter applying the given budgets, keyed by
+// request class.
+func NewRateLimiter(limits map[string]RateLimit) *RateLimiter {
+	return &RateLimiter{
+		limits:    limits,
+		buckets:   map[bucketKey]*rate.Limiter{},
+		lastSweep: time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket of the client. It returns false and
+// how long to wait for the next token if the bucket is empty.
+func (l *RateLimiter) reserve(
+	class, client string, now time.Time,
+) (time.Duration, bool) {
+	return l.take(class, client, now, true)
+}
+
+// check is the same as reserve, except that the token is left in the bucket.
+func (l *RateLimiter) check(
+	class, client string, now time.Time,
+) (time.Duration, bool) {
+	return l.take(class, client, now, false)
+}
+
+func (l *RateLimiter) take(
+	class, client string, now time.Time, keep bool,
+) (time.Duration, bool) {
+	limit, ok := l.limits[class]
+	if !ok {
+		return 0, true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) >= limiterSweepInterval {
+		l.sweep(now)
+	}
+	key := bucketKey{class: class, client: client}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(limit.Rate, limit.Burst)
+		l.buckets[key] = bucket
+	}
+	r := bucket.ReserveN(now, 1)
+	if !r.OK() {
+		return time.Duration(math.MaxInt64), false
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	if !keep {
+		r.CancelAt(now)
+	}
+	return 0, true
+}
+
+// sweep drops full buckets, which are the same as new ones.
+func (l *RateLimiter) sweep(now time.Time) {
+	for key, bucket := range l.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// middleware answers requests exceeding the budget of their client with `429`.
+// Clients are identified by the authenticated subject, or the IP address of
+// anonymous requests.
+func (l *RateLimiter) middleware(gc *gin.Context) {
+	client := "ip:" + gc.ClientIP()
+	if p := PrincipalFromContext(gc.Request.Context()); nil != p {
+		client = "sub:" + p.Subject
+	}
+	delay, ok := l.reserve(requestClass(gc.Request), client, time.Now())
+	if ok {
+		gc.Next()
+		return
+	}
+	abortTooManyRequests(gc, delay)
+}
+
+// authGuard charges requests failing authentication against the budget of
+// their IP address, the same one as anonymous requests. Once it runs out,
+// requests from the address are answered with `429` before authentication, so
+// that credentials can't be guessed faster than the budget allows.
+func (l *RateLimiter) authGuard(gc *gin.Context) {
+	client := "ip:" + gc.ClientIP()
+	class := requestClass(gc.Request)
+	if delay, ok := l.check(class, client, time.Now()); !ok {
+		abortTooManyRequests(gc, delay)
+		return
+	}
+	gc.Next()
+	if http.StatusUnauthorized == gc.Writer.Status() {
+		l.reserve(class, client, time.Now())
+	}
+}
+
+// abortTooManyRequests answers the request with `429`, telling the client to
+// retry after the given delay.
+func abortTooManyRequests(gc *gin.Context, delay time.Duration) {
+	err := errors.New("rate limit exceeded")
+	_ = gc.Error(err)
+	gc.Header(
+		"Retry-After",
+		strconv.FormatInt(int64(math.Ceil(min(delay, time.Hour).Seconds())), 10),
+	)
+	abortWithError(
+		gc, newApiError(http.StatusTooManyRequests, err, err.Error()),
+	)
+}
+
+// requestClass returns the class of budget applied to the request.
+func requestClass(req *http.Request) string {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		recurse, _ := strconv.ParseBool(req.URL.Query().Get("recurse"))
+		if recurse {
+			return limitRecursive
+		}
+		return limitRead
+	default:
+		return limitWrite
+	}
+}
+
+// bodyLimitMiddleware answers POST, PUT & PATCH requests with bodies larger
+// than `limit` bytes with `413`.
+func bodyLimitMiddleware(limit int64) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		switch gc.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			gc.Next()
+			return
+		}
+		if nil == gc.Request.Body || http.NoBody == gc.Request.Body {
+			gc.Next()
+			return
+		}
+		// bodies are small JSON documents, reading them up front lets the
+		// limit be enforced before any handler starts working
+		body, err := io.ReadAll(
+			http.MaxBytesReader(gc.Writer, gc.Request.Body, limit),
+		)
+		if err != nil {
+			_ = gc.Error(err)
+			code := http.StatusBadRequest
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				code = http.StatusRequestEntityTooLarge
+			}
+			abortWithError(gc, newApiError(code, err, err.Error()))
+			return
+		}
+		gc.Request.Body = io.NopCloser(bytes.NewReader(body))
+		gc.Next()
+	}
+}
+
+// parseRateLimit parses budgets in the form of `<requests>/<period>`, e.g.
+// `100/1m` or `5/s`. Bursts of all the requests are allowed.
+func parseRateLimit(val string) (RateLimit, error) {
+	count, period, found := strings.Cut(val, "/")
+	if !found {
+		return RateLimit{}, errors.New("expecting `<requests>/<period>`")
+	}
+	burst, err := strconv.Atoi(strings.TrimSpace(count))
+	if err != nil || burst < 1 {
+		return RateLimit{}, fmt.Errorf("invalid number of requests %q", count)
+	}
+	period = strings.TrimSpace(period)
+	if "" != period && (period[0] < '0' || period[0] > '9') {
+		period = "1" + period
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return RateLimit{}, fmt.Errorf("invalid period %q", period)
+	}
+	return RateLimit{
+		Rate: rate.Limit(float64(burst) / d.Seconds()), Burst: burst,
+	}, nil
+}
+
+// getRateLimits returns budgets of the configuration. Requests of classes
+// without budget are not limited.
+func getRateLimits(cfg LimitsConfig) (map[string]RateLimit, error) {
+	limits := map[string]RateLimit{}
+	for _, budget := range []struct{ class, name, val string }{
+		{limitRead, "RATE_LIMIT_READ", cfg.RateRead},
+		{limitWrite, "RATE_LIMIT_WRITE", cfg.RateWrite},
+		{limitRecursive, "RATE_LIMIT_RECURSIVE", cfg.RateRecursive},
+	} {
+		if "" == budget.val {
+			continue
+		}
+		limit, err := parseRateLimit(budget.val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", budget.name, err)
+		}
+		limits[budget.class] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_parseRateLimit(t *testing.T) {
+	tests := []struct {
+		val      string
+		expected RateLimit
+		err      bool
+	}{
+		{"10/s", RateLimit{Rate: 10, Burst: 10}, false},
+		{"120/1m", RateLimit{Rate: 2, Burst: 120}, false},
+		{" 5 / 10s ", RateLimit{Rate: 0.5, Burst: 5}, false},
+		{"10", RateLimit{}, true},
+		{"0/s", RateLimit{}, true},
+		{"x/s", RateLimit{}, true},
+		{"10/forever", RateLimit{}, true},
+		{"10/-1s", RateLimit{}, true},
+	}
+	for _, test := range tests {
+		actual, err := parseRateLimit(test.val)
+		assert.Equal(t, test.err, nil != err, test.val)
+		assert.Equal(t, test.expected, actual, test.val)
+	}
+}
+
+func Test_RateLimiter_refills_buckets(t *testing.T) {
+	limiter := NewRateLimiter(
+		map[string]RateLimit{limitRead: {Rate: 1, Burst: 1}},
+	)
+	now := time.Now()
+	_, ok := limiter.reserve(limitRead, "a", now)
+	assert.True(t, ok)
+	delay, ok := limiter.reserve(limitRead, "a", now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, delay)
+	_, ok = limiter.reserve(limitRead, "b", now)
+	assert.True(t, ok)
+	_, ok = limiter.reserve(limitWrite, "a", now)
+	assert.True(t, ok)
+	_, ok = limiter.reserve(limitRead, "a", now.Add(time.Second))
+	assert.True(t, ok)
+}
+
+func Test_RateLimiter_sweeps_full_buckets(t *testing.T) {
+	limiter := NewRateLimiter(
+		map[string]RateLimit{
+			limitRead: {Rate: rate.Every(time.Hour), Burst: 1},
+		},
+	)
+	now := time.Now()
+	limiter.reserve(limitRead, "a", now)
+	limiter.reserve(limitRead, "b", now.Add(limiterSweepInterval))
+	assert.Len(t, limiter.buckets, 2)
+	limiter.reserve(limitRead, "b", now.Add(2*time.Hour))
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func Test_rate_limit_reports_429_with_retry_after(t *testing.T) {
+	t.Setenv("RATE_LIMIT_WRITE", "2/1m")
+	t.Setenv("TRUSTED_PROXIES", "192.0.2.1")
+	_, engine, _, _ := setupGinTest(t)
+	url := schema.BaseUri + "/2"
+	body := `{"name":"new name"}`
+	for range 2 {
+		res := serveRequest(
+			engine, http.MethodPatch, url, body,
+			withHeader("X-Forwarded-For", "10.0.0.1"),
+		)
+		assert.Equal(t, http.StatusOK, res.Code)
+	}
+	res := serveRequest(
+		engine, http.MethodPatch, url, body,
+		withHeader("X-Forwarded-For", "10.0.0.1"),
+	)
+	assert.Equal(t, http.StatusTooManyRequests, res.Code)
+	assert.Equal(t, "30", res.Header().Get("Retry-After"))
+	var actual N429ApplicationJSON
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, http.StatusTooManyRequests, actual.Code)
+	// reads and other clients have their own budgets
+	res = serveRequest(
+		engine, http.MethodGet, url, "",
+		withHeader("X-Forwarded-For", "10.0.0.1"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodPatch, url, body,
+		withHeader("X-Forwarded-For", "10.0.0.2"),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_rate_limit_applies_recursive_budget(t *testing.T) {
+	t.Setenv("RATE_LIMIT_RECURSIVE", "1/1h")
+	_, engine, _, _ := setupGinTest(t)
+	url := schema.BaseUri + "/1/children"
+	res := serveRequest(
+		engine, http.MethodGet, url+"?recurse=1", "",
+		withHeader("X-Forwarded-For", ""),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	res = serveRequest(
+		engine, http.MethodGet, url+"?recurse=true", "",
+		withHeader("X-Forwarded-For", ""),
+	)
+	assert.Equal(t, http.StatusTooManyRequests, res.Code)
+	assert.Equal(t, "3600", res.Header().Get("Retry-After"))
+	res = serveRequest(
+		engine, http.MethodGet, url, "", withHeader("X-Forwarded-For", ""),
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_rate_limit_charges_failed_authentication_to_ip(t *testing.T) {
+	t.Setenv("RATE_LIMIT_READ", "2/1m")
+	t.Setenv("TRUSTED_PROXIES", "192.0.2.1")
+	t.Setenv(
+		"AUTH_API_KEYS_FILE", writeTestFile(t, "keys", "alice:alice-key\n"),
+	)
+	_, engine, _, _ := setupGinTest(t)
+	url := schema.BaseUri + "/1"
+	for range 2 {
+		res := serveRequest(
+			engine, http.MethodGet, url, "",
+			withHeader("X-Forwarded-For", "10.0.0.1"),
+			withHeader(HeaderApiKey, "guess"),
+		)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res := serveRequest(
+		engine, http.MethodGet, url, "",
+		withHeader("X-Forwarded-For", "10.0.0.1"),
+		withHeader(HeaderApiKey, "guess"),
+	)
+	assert.Equal(t, http.StatusTooManyRequests, res.Code)
+	assert.Equal(t, "30", res.Header().Get("Retry-After"))
+	// authenticated requests are charged to the subject instead
+	for range 2 {
+		res = serveRequest(
+			engine, http.MethodGet, url, "",
+			withHeader("X-Forwarded-For", "10.0.0.2"),
+			withHeader(HeaderApiKey, "alice-key"),
+		)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	}
+	res = serveRequest(
+		engine, http.MethodGet, url, "",
+		withHeader("X-Forwarded-For", "10.0.0.2"),
+		withHeader(HeaderApiKey, "guess"),
+	)
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_rate_limit_fails_on_invalid_configuration(t *testing.T) {
+	setTestEnv(t)
+	t.Setenv("RATE_LIMIT_READ", "lots")
+	_, err := loadConfig(nil)
+	assert.ErrorContains(t, err, "RATE_LIMIT_READ")
+}
+
+func Test_body_limit_reports_413(t *testing.T) {
+	t.Setenv("MAX_BODY_SIZE", "32")
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri,
+		`{"name":"`+strings.Repeat("a", 32)+`"}`,
+		withHeader("X-Forwarded-For", ""),
+	)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+	var actual N413ApplicationJSON
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, actual.Code)
+	res = serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"new name"}`,
+		withHeader("X-Forwarded-For", ""),
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -22,29 +23,74 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List Items
-	// (GET /simple-tree)
+	// (GET /)
 	ListItem(c *gin.Context, params ListItemParams)
 	// Create a new Item
-	// (POST /simple-tree)
+	// (POST /)
 	CreateItem(c *gin.Context)
+	// Stream Item change events
+	// (GET /events)
+	StreamItemEvents(c *gin.Context, params StreamItemEventsParams)
+	// List Webhooks
+	// (GET /webhooks)
+	ListWebhook(c *gin.Context, params ListWebhookParams)
+	// Create a new Webhook
+	// (POST /webhooks)
+	CreateWebhook(c *gin.Context)
+	// Deletes a Webhook by ID
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(c *gin.Context, id uint32)
+	// Find a Webhook by ID
+	// (GET /webhooks/{id})
+	ReadWebhook(c *gin.Context, id uint32)
+	// Updates a Webhook
+	// (PATCH /webhooks/{id})
+	UpdateWebhook(c *gin.Context, id uint32)
+	// List dead letters of a Webhook
+	// (GET /webhooks/{id}/dead-letters)
+	ListWebhookDeadLetter(c *gin.Context, id uint32, params ListWebhookDeadLetterParams)
 	// Deletes a Item by ID
-	// (DELETE /simple-tree/{id})
+	// (DELETE /{id})
 	DeleteItem(c *gin.Context, id uint32, params DeleteItemParams)
 	// Find a Item by ID
-	// (GET /simple-tree/{id})
+	// (GET /{id})
 	ReadItem(c *gin.Context, id uint32, params ReadItemParams)
 	// Updates a Item
-	// (PATCH /simple-tree/{id})
+	// (PATCH /{id})
 	UpdateItem(c *gin.Context, id uint32)
 	// List of subordinate items
-	// (GET /simple-tree/{id}/children)
+	// (GET /{id}/children)
 	ListItemChildren(c *gin.Context, id uint32, params ListItemChildrenParams)
+	// Copies a Item with its descendants
+	// (POST /{id}/copy)
+	CopyItem(c *gin.Context, id uint32)
+	// List grants of an Item
+	// (GET /{id}/grants)
+	ListItemGrant(c *gin.Context, id uint32)
+	// Revokes the grant of a subject on an Item
+	// (DELETE /{id}/grants/{subject})
+	DeleteItemGrant(c *gin.Context, id uint32, subject string)
+	// Grants a subject permission on an Item
+	// (PUT /{id}/grants/{subject})
+	SetItemGrant(c *gin.Context, id uint32, subject string)
+	// List change history of an Item
+	// (GET /{id}/history)
+	ListItemHistory(c *gin.Context, id uint32, params ListItemHistoryParams)
 	// Find the attached Item
-	// (GET /simple-tree/{id}/parent)
-	ReadItemParent(c *gin.Context, id uint32)
+	// (GET /{id}/parent)
+	ReadItemParent(c *gin.Context, id uint32, params ReadItemParentParams)
+	// Find how two Items relate
+	// (GET /{id}/relation/{other})
+	ReadItemRelation(c *gin.Context, id uint32, other uint32)
 	// Restore a trashed record
-	// (POST /simple-tree/{id}/restore)
+	// (POST /{id}/restore)
 	RestoreItem(c *gin.Context, id uint32)
+	// Reverts a Item to a previous revision
+	// (POST /{id}/revert)
+	RevertItem(c *gin.Context, id uint32)
+	// List of sibling items
+	// (GET /{id}/siblings)
+	ListItemSiblings(c *gin.Context, id uint32, params ListItemSiblingsParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -61,6 +107,10 @@ func (siw *ServerInterfaceWrapper) ListItem(c *gin.Context) {
 
 	var err error
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ListItemParams
 
@@ -88,6 +138,46 @@ func (siw *ServerInterfaceWrapper) ListItem(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagination", c.Request.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagination: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter as_of: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "expand", c.Request.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter expand: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "trashed" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
@@ -109,6 +199,10 @@ func (siw *ServerInterfaceWrapper) ListItem(c *gin.Context) {
 // CreateItem operation middleware
 func (siw *ServerInterfaceWrapper) CreateItem(c *gin.Context) {
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -119,28 +213,74 @@ func (siw *ServerInterfaceWrapper) CreateItem(c *gin.Context) {
 	siw.Handler.CreateItem(c)
 }
 
-// DeleteItem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteItem(c *gin.Context) {
+// StreamItemEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamItemEvents(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id uint32
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamItemEventsParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
 	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StreamItemEvents(c, params)
+}
+
+// ListWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhook(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteItemParams
+	var params ListWebhookParams
 
-	// ------------- Optional query parameter "trashed" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -151,11 +291,28 @@ func (siw *ServerInterfaceWrapper) DeleteItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteItem(c, id, params)
+	siw.Handler.ListWebhook(c, params)
 }
 
-// ReadItem operation middleware
-func (siw *ServerInterfaceWrapper) ReadItem(c *gin.Context) {
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateWebhook(c)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(c *gin.Context) {
 
 	var err error
 
@@ -168,17 +325,38 @@ func (siw *ServerInterfaceWrapper) ReadItem(c *gin.Context) {
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ReadItemParams
+	c.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "trashed" -------------
+	c.Set(ApiKeyAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhook(c, id)
+}
+
+// ReadWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ReadWebhook(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -186,11 +364,11 @@ func (siw *ServerInterfaceWrapper) ReadItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReadItem(c, id, params)
+	siw.Handler.ReadWebhook(c, id)
 }
 
-// UpdateItem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(c *gin.Context) {
 
 	var err error
 
@@ -203,6 +381,10 @@ func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -210,11 +392,11 @@ func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateItem(c, id)
+	siw.Handler.UpdateWebhook(c, id)
 }
 
-// ListItemChildren operation middleware
-func (siw *ServerInterfaceWrapper) ListItemChildren(c *gin.Context) {
+// ListWebhookDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeadLetter(c *gin.Context) {
 
 	var err error
 
@@ -227,8 +409,12 @@ func (siw *ServerInterfaceWrapper) ListItemChildren(c *gin.Context) {
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListItemChildrenParams
+	var params ListWebhookDeadLetterParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -246,19 +432,42 @@ func (siw *ServerInterfaceWrapper) ListItemChildren(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	siw.Handler.ListWebhookDeadLetter(c, id, params)
+}
+
+// DeleteItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "recurse" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "recurse", c.Request.URL.Query(), &params.Recurse)
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteItemParams
+
+	// ------------- Optional query parameter "trashed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter recurse: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -269,11 +478,11 @@ func (siw *ServerInterfaceWrapper) ListItemChildren(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListItemChildren(c, id, params)
+	siw.Handler.DeleteItem(c, id, params)
 }
 
-// ReadItemParent operation middleware
-func (siw *ServerInterfaceWrapper) ReadItemParent(c *gin.Context) {
+// ReadItem operation middleware
+func (siw *ServerInterfaceWrapper) ReadItem(c *gin.Context) {
 
 	var err error
 
@@ -286,6 +495,45 @@ func (siw *ServerInterfaceWrapper) ReadItemParent(c *gin.Context) {
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadItemParams
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter as_of: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "expand", c.Request.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter expand: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "trashed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -293,11 +541,11 @@ func (siw *ServerInterfaceWrapper) ReadItemParent(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReadItemParent(c, id)
+	siw.Handler.ReadItem(c, id, params)
 }
 
-// RestoreItem operation middleware
-func (siw *ServerInterfaceWrapper) RestoreItem(c *gin.Context) {
+// UpdateItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
 
 	var err error
 
@@ -310,6 +558,10 @@ func (siw *ServerInterfaceWrapper) RestoreItem(c *gin.Context) {
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -317,375 +569,4519 @@ func (siw *ServerInterfaceWrapper) RestoreItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RestoreItem(c, id)
+	siw.Handler.UpdateItem(c, id)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// ListItemChildren operation middleware
+func (siw *ServerInterfaceWrapper) ListItemChildren(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/simple-tree", wrapper.ListItem)
-	router.POST(options.BaseURL+"/simple-tree", wrapper.CreateItem)
-	router.DELETE(options.BaseURL+"/simple-tree/:id", wrapper.DeleteItem)
-	router.GET(options.BaseURL+"/simple-tree/:id", wrapper.ReadItem)
-	router.PATCH(options.BaseURL+"/simple-tree/:id", wrapper.UpdateItem)
-	router.GET(options.BaseURL+"/simple-tree/:id/children", wrapper.ListItemChildren)
-	router.GET(options.BaseURL+"/simple-tree/:id/parent", wrapper.ReadItemParent)
-	router.POST(options.BaseURL+"/simple-tree/:id/restore", wrapper.RestoreItem)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type N400JSONResponse struct {
-	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
-	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
-	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
-}
+	c.Set(ApiKeyAuthScopes, []string{})
 
-type N404JSONResponse struct {
-	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
-	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
-	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemChildrenParams
 
-type N409JSONResponse struct {
-	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
-	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
-	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
-}
+	// ------------- Optional query parameter "page" -------------
 
-type N500JSONResponse struct {
-	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
-	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
-	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "recurse" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recurse", c.Request.URL.Query(), &params.Recurse)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter recurse: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagination", c.Request.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagination: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter as_of: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "expand", c.Request.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter expand: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "trashed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListItemChildren(c, id, params)
+}
+
+// CopyItem operation middleware
+func (siw *ServerInterfaceWrapper) CopyItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CopyItem(c, id)
+}
+
+// ListItemGrant operation middleware
+func (siw *ServerInterfaceWrapper) ListItemGrant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListItemGrant(c, id)
+}
+
+// DeleteItemGrant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemGrant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "subject" -------------
+	var subject string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subject", c.Param("subject"), &subject, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteItemGrant(c, id, subject)
+}
+
+// SetItemGrant operation middleware
+func (siw *ServerInterfaceWrapper) SetItemGrant(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "subject" -------------
+	var subject string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subject", c.Param("subject"), &subject, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetItemGrant(c, id, subject)
+}
+
+// ListItemHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListItemHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemHistoryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListItemHistory(c, id, params)
+}
+
+// ReadItemParent operation middleware
+func (siw *ServerInterfaceWrapper) ReadItemParent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadItemParentParams
+
+	// ------------- Optional query parameter "trashed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReadItemParent(c, id, params)
+}
+
+// ReadItemRelation operation middleware
+func (siw *ServerInterfaceWrapper) ReadItemRelation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "other" -------------
+	var other uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "other", c.Param("other"), &other, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter other: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReadItemRelation(c, id, other)
+}
+
+// RestoreItem operation middleware
+func (siw *ServerInterfaceWrapper) RestoreItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreItem(c, id)
+}
+
+// RevertItem operation middleware
+func (siw *ServerInterfaceWrapper) RevertItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevertItem(c, id)
+}
+
+// ListItemSiblings operation middleware
+func (siw *ServerInterfaceWrapper) ListItemSiblings(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemSiblingsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_self" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_self", c.Request.URL.Query(), &params.ExcludeSelf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude_self: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagination", c.Request.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagination: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "expand", c.Request.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter expand: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "trashed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListItemSiblings(c, id, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/", wrapper.ListItem)
+	router.POST(options.BaseURL+"/", wrapper.CreateItem)
+	router.GET(options.BaseURL+"/events", wrapper.StreamItemEvents)
+	router.GET(options.BaseURL+"/webhooks", wrapper.ListWebhook)
+	router.POST(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	router.DELETE(options.BaseURL+"/webhooks/:id", wrapper.DeleteWebhook)
+	router.GET(options.BaseURL+"/webhooks/:id", wrapper.ReadWebhook)
+	router.PATCH(options.BaseURL+"/webhooks/:id", wrapper.UpdateWebhook)
+	router.GET(options.BaseURL+"/webhooks/:id/dead-letters", wrapper.ListWebhookDeadLetter)
+	router.DELETE(options.BaseURL+"/:id", wrapper.DeleteItem)
+	router.GET(options.BaseURL+"/:id", wrapper.ReadItem)
+	router.PATCH(options.BaseURL+"/:id", wrapper.UpdateItem)
+	router.GET(options.BaseURL+"/:id/children", wrapper.ListItemChildren)
+	router.POST(options.BaseURL+"/:id/copy", wrapper.CopyItem)
+	router.GET(options.BaseURL+"/:id/grants", wrapper.ListItemGrant)
+	router.DELETE(options.BaseURL+"/:id/grants/:subject", wrapper.DeleteItemGrant)
+	router.PUT(options.BaseURL+"/:id/grants/:subject", wrapper.SetItemGrant)
+	router.GET(options.BaseURL+"/:id/history", wrapper.ListItemHistory)
+	router.GET(options.BaseURL+"/:id/parent", wrapper.ReadItemParent)
+	router.GET(options.BaseURL+"/:id/relation/:other", wrapper.ReadItemRelation)
+	router.POST(options.BaseURL+"/:id/restore", wrapper.RestoreItem)
+	router.POST(options.BaseURL+"/:id/revert", wrapper.RevertItem)
+	router.GET(options.BaseURL+"/:id/siblings", wrapper.ListItemSiblings)
+}
+
+type N400JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N400ApplicationProblemPlusJSONResponse Problem
+
+type N401JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N401ApplicationProblemPlusJSONResponse Problem
+
+type N403JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N403ApplicationProblemPlusJSONResponse Problem
+
+type N404JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N404ApplicationProblemPlusJSONResponse Problem
+
+type N409JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N409ApplicationProblemPlusJSONResponse Problem
+
+type N413JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N413ApplicationProblemPlusJSONResponse Problem
+
+type N422JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N422ApplicationProblemPlusJSONResponse Problem
+
+type N429ResponseHeaders struct {
+	RetryAfter int
+}
+type N429JSONResponse struct {
+	Body struct {
+		Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+		Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+		Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+	}
+
+	Headers N429ResponseHeaders
+}
+type N429ApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers N429ResponseHeaders
+}
+
+type N500JSONResponse struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+type N500ApplicationProblemPlusJSONResponse Problem
+
+type ListItemRequestObject struct {
+	Params ListItemParams
+}
+
+type ListItemResponseObject interface {
+	VisitListItemResponse(w http.ResponseWriter) error
+}
+
+type ListItem200JSONResponse struct {
+	// CurrentPage Page number (1-based), 0 in cursor pagination
+	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
+
+	// Data List of items
+	Data []ItemList `json:"data" yaml:"data" xml:"data" bson:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
+
+	// LastPage Last page number, 0 in cursor pagination
+	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
+
+	// LastPageUrl URL to the last page, empty in cursor pagination
+	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
+
+	// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty" yaml:"next_cursor,omitempty" xml:"next_cursor,omitempty" bson:"next_cursor,omitempty"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path" yaml:"path" xml:"path" bson:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
+
+	// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+	PrevCursor *string `json:"prev_cursor,omitempty" yaml:"prev_cursor,omitempty" xml:"prev_cursor,omitempty" bson:"prev_cursor,omitempty"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
+
+	// Total Total number of items, 0 in cursor pagination
+	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
+}
+
+func (response ListItem200JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem400JSONResponse struct{ N400JSONResponse }
+
+func (response ListItem400JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem400ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem401JSONResponse struct{ N401JSONResponse }
+
+func (response ListItem401JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem401ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem403JSONResponse struct{ N403JSONResponse }
+
+func (response ListItem403JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem403ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem404JSONResponse struct{ N404JSONResponse }
+
+func (response ListItem404JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem404ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem409JSONResponse struct{ N409JSONResponse }
+
+func (response ListItem409JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem409ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem429JSONResponse struct{ N429JSONResponse }
+
+func (response ListItem429JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem429ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItem500JSONResponse struct{ N500JSONResponse }
+
+func (response ListItem500JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItem500ApplicationProblemPlusJSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItemRequestObject struct {
+	Body *CreateItemJSONRequestBody
+}
+
+type CreateItemResponseObject interface {
+	VisitCreateItemResponse(w http.ResponseWriter) error
+}
+
+type CreateItem200JSONResponse ItemCreate
+
+func (response CreateItem200JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateItem400JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem400ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateItem401JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem401ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateItem403JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem403ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateItem409JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem409ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem413JSONResponse struct{ N413JSONResponse }
+
+func (response CreateItem413JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem413ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateItem422JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem422ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateItem429JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem429ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateItem500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateItem500JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateItem500ApplicationProblemPlusJSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEventsRequestObject struct {
+	Params StreamItemEventsParams
+}
+
+type StreamItemEventsResponseObject interface {
+	VisitStreamItemEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamItemEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamItemEvents200TexteventStreamResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamItemEvents400JSONResponse struct{ N400JSONResponse }
+
+func (response StreamItemEvents400JSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response StreamItemEvents400ApplicationProblemPlusJSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents401JSONResponse struct{ N401JSONResponse }
+
+func (response StreamItemEvents401JSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response StreamItemEvents401ApplicationProblemPlusJSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents403JSONResponse struct{ N403JSONResponse }
+
+func (response StreamItemEvents403JSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response StreamItemEvents403ApplicationProblemPlusJSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents429JSONResponse struct{ N429JSONResponse }
+
+func (response StreamItemEvents429JSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StreamItemEvents429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response StreamItemEvents429ApplicationProblemPlusJSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StreamItemEvents500JSONResponse struct{ N500JSONResponse }
+
+func (response StreamItemEvents500JSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamItemEvents500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response StreamItemEvents500ApplicationProblemPlusJSONResponse) VisitStreamItemEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookRequestObject struct {
+	Params ListWebhookParams
+}
+
+type ListWebhookResponseObject interface {
+	VisitListWebhookResponse(w http.ResponseWriter) error
+}
+
+type ListWebhook200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
+
+	// Data List of items
+	Data []WebhookList `json:"data" yaml:"data" xml:"data" bson:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path" yaml:"path" xml:"path" bson:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
+
+	// Total Total number of items
+	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
+}
+
+func (response ListWebhook200JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response ListWebhook400JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook400ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response ListWebhook401JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook401ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response ListWebhook403JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook403ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response ListWebhook404JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook404ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response ListWebhook409JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook409ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook429JSONResponse struct{ N429JSONResponse }
+
+func (response ListWebhook429JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhook429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook429ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response ListWebhook500JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhook500ApplicationProblemPlusJSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook200JSONResponse WebhookCreate
+
+func (response CreateWebhook200JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook400ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook401ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook403ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateWebhook409JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook409ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook413JSONResponse struct{ N413JSONResponse }
+
+func (response CreateWebhook413JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook413ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateWebhook422JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook422ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateWebhook429JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWebhook429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook429ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response CreateWebhook500ApplicationProblemPlusJSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook400ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook401ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook403ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook404ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteWebhook409JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook409ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook429JSONResponse struct{ N429JSONResponse }
+
+func (response DeleteWebhook429JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWebhook429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook429ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteWebhook500ApplicationProblemPlusJSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhookRequestObject struct {
+	Id uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+}
+
+type ReadWebhookResponseObject interface {
+	VisitReadWebhookResponse(w http.ResponseWriter) error
+}
+
+type ReadWebhook200JSONResponse WebhookRead
+
+func (response ReadWebhook200JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadWebhook400JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook400ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadWebhook401JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook401ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadWebhook403JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook403ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadWebhook404JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook404ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadWebhook409JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook409ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook429JSONResponse struct{ N429JSONResponse }
+
+func (response ReadWebhook429JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadWebhook429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook429ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadWebhook500JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadWebhook500ApplicationProblemPlusJSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	Id   uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Body *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse WebhookUpdate
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook400ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook401ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook403ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook404ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateWebhook409JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook409ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook413JSONResponse struct{ N413JSONResponse }
+
+func (response UpdateWebhook413JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook413ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateWebhook422JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook422ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook429JSONResponse struct{ N429JSONResponse }
+
+func (response UpdateWebhook429JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateWebhook429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook429ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateWebhook500ApplicationProblemPlusJSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetterRequestObject struct {
+	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Params ListWebhookDeadLetterParams
+}
+
+type ListWebhookDeadLetterResponseObject interface {
+	VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeadLetter200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
+
+	// Data List of items
+	Data []WebhookDelivery `json:"data" yaml:"data" xml:"data" bson:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path" yaml:"path" xml:"path" bson:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
+
+	// Total Total number of items
+	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
+}
+
+func (response ListWebhookDeadLetter200JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter400JSONResponse struct{ N400JSONResponse }
+
+func (response ListWebhookDeadLetter400JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter400ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter401JSONResponse struct{ N401JSONResponse }
+
+func (response ListWebhookDeadLetter401JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter401ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter403JSONResponse struct{ N403JSONResponse }
+
+func (response ListWebhookDeadLetter403JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter403ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter404JSONResponse struct{ N404JSONResponse }
+
+func (response ListWebhookDeadLetter404JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter404ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter409JSONResponse struct{ N409JSONResponse }
+
+func (response ListWebhookDeadLetter409JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter409ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter429JSONResponse struct{ N429JSONResponse }
+
+func (response ListWebhookDeadLetter429JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhookDeadLetter429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter429ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWebhookDeadLetter500JSONResponse struct{ N500JSONResponse }
+
+func (response ListWebhookDeadLetter500JSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeadLetter500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ListWebhookDeadLetter500ApplicationProblemPlusJSONResponse) VisitListWebhookDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemRequestObject struct {
+	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Params DeleteItemParams
+}
+
+type DeleteItemResponseObject interface {
+	VisitDeleteItemResponse(w http.ResponseWriter) error
+}
+
+type DeleteItem204Response struct {
+}
+
+func (response DeleteItem204Response) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteItem400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteItem400JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem400ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteItem401JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem401ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteItem403JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem403ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteItem404JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem404ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteItem409JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem409ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem429JSONResponse struct{ N429JSONResponse }
+
+func (response DeleteItem429JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem429ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteItem500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteItem500JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItem500ApplicationProblemPlusJSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemRequestObject struct {
+	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Params ReadItemParams
+}
+
+type ReadItemResponseObject interface {
+	VisitReadItemResponse(w http.ResponseWriter) error
+}
+
+type ReadItem200JSONResponse ItemRead
+
+func (response ReadItem200JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadItem400JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem400ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadItem401JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem401ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadItem403JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem403ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadItem404JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem404ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadItem409JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem409ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem429JSONResponse struct{ N429JSONResponse }
+
+func (response ReadItem429JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem429ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadItem500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadItem500JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItem500ApplicationProblemPlusJSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItemRequestObject struct {
+	Id   uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Body *UpdateItemJSONRequestBody
+}
+
+type UpdateItemResponseObject interface {
+	VisitUpdateItemResponse(w http.ResponseWriter) error
+}
+
+type UpdateItem200JSONResponse ItemUpdate
+
+func (response UpdateItem200JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateItem400JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem400ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateItem401JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem401ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateItem403JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem403ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateItem404JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem404ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateItem409JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem409ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem413JSONResponse struct{ N413JSONResponse }
+
+func (response UpdateItem413JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem413ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateItem422JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem422ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem429JSONResponse struct{ N429JSONResponse }
+
+func (response UpdateItem429JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem429ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateItem500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateItem500JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateItem500ApplicationProblemPlusJSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildrenRequestObject struct {
+	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Params ListItemChildrenParams
+}
+
+type ListItemChildrenResponseObject interface {
+	VisitListItemChildrenResponse(w http.ResponseWriter) error
+}
+
+type ListItemChildren200JSONResponse struct {
+	// CurrentPage Page number (1-based), 0 in cursor pagination
+	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
+
+	// Data List of items
+	Data []ItemList `json:"data" yaml:"data" xml:"data" bson:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page, 0 in cursor pagination
+	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
+
+	// LastPage Last page number, 0 in cursor pagination
+	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
+
+	// LastPageUrl URL to the last page, empty in cursor pagination
+	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
+
+	// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty" yaml:"next_cursor,omitempty" xml:"next_cursor,omitempty" bson:"next_cursor,omitempty"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path" yaml:"path" xml:"path" bson:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
+
+	// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+	PrevCursor *string `json:"prev_cursor,omitempty" yaml:"prev_cursor,omitempty" xml:"prev_cursor,omitempty" bson:"prev_cursor,omitempty"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page, 0 in cursor pagination
+	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
+
+	// Total Total number of items, 0 in cursor pagination
+	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
+}
+
+func (response ListItemChildren200JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren400JSONResponse struct{ N400JSONResponse }
+
+func (response ListItemChildren400JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren400ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren401JSONResponse struct{ N401JSONResponse }
+
+func (response ListItemChildren401JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren401ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren403JSONResponse struct{ N403JSONResponse }
+
+func (response ListItemChildren403JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren403ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren404JSONResponse struct{ N404JSONResponse }
+
+func (response ListItemChildren404JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren404ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren409JSONResponse struct{ N409JSONResponse }
+
+func (response ListItemChildren409JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren409ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren429JSONResponse struct{ N429JSONResponse }
+
+func (response ListItemChildren429JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemChildren429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren429ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemChildren500JSONResponse struct{ N500JSONResponse }
+
+func (response ListItemChildren500JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemChildren500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemChildren500ApplicationProblemPlusJSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItemRequestObject struct {
+	Id   uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Body *CopyItemJSONRequestBody
+}
+
+type CopyItemResponseObject interface {
+	VisitCopyItemResponse(w http.ResponseWriter) error
+}
+
+type CopyItem201JSONResponse ItemCopy
+
+func (response CopyItem201JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem400JSONResponse struct{ N400JSONResponse }
+
+func (response CopyItem400JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem400ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem401JSONResponse struct{ N401JSONResponse }
+
+func (response CopyItem401JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem401ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem403JSONResponse struct{ N403JSONResponse }
+
+func (response CopyItem403JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem403ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem404JSONResponse struct{ N404JSONResponse }
+
+func (response CopyItem404JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem404ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem409JSONResponse struct{ N409JSONResponse }
+
+func (response CopyItem409JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem409ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem413JSONResponse struct{ N413JSONResponse }
+
+func (response CopyItem413JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem413ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem422JSONResponse struct{ N422JSONResponse }
+
+func (response CopyItem422JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem422ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem429JSONResponse struct{ N429JSONResponse }
+
+func (response CopyItem429JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CopyItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem429ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CopyItem500JSONResponse struct{ N500JSONResponse }
+
+func (response CopyItem500JSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response CopyItem500ApplicationProblemPlusJSONResponse) VisitCopyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrantRequestObject struct {
+	Id uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+}
+
+type ListItemGrantResponseObject interface {
+	VisitListItemGrantResponse(w http.ResponseWriter) error
+}
+
+type ListItemGrant200JSONResponse []Grant
+
+func (response ListItemGrant200JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant400JSONResponse struct{ N400JSONResponse }
+
+func (response ListItemGrant400JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemGrant400ApplicationProblemPlusJSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant401JSONResponse struct{ N401JSONResponse }
+
+func (response ListItemGrant401JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemGrant401ApplicationProblemPlusJSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant403JSONResponse struct{ N403JSONResponse }
+
+func (response ListItemGrant403JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemGrant403ApplicationProblemPlusJSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant404JSONResponse struct{ N404JSONResponse }
+
+func (response ListItemGrant404JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemGrant404ApplicationProblemPlusJSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant429JSONResponse struct{ N429JSONResponse }
+
+func (response ListItemGrant429JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemGrant429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemGrant429ApplicationProblemPlusJSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemGrant500JSONResponse struct{ N500JSONResponse }
+
+func (response ListItemGrant500JSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemGrant500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemGrant500ApplicationProblemPlusJSONResponse) VisitListItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrantRequestObject struct {
+	Id      uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Subject string `json:"subject" yaml:"subject" xml:"subject" bson:"subject"`
+}
+
+type DeleteItemGrantResponseObject interface {
+	VisitDeleteItemGrantResponse(w http.ResponseWriter) error
+}
+
+type DeleteItemGrant204Response struct {
+}
+
+func (response DeleteItemGrant204Response) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteItemGrant400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteItemGrant400JSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemGrant400ApplicationProblemPlusJSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteItemGrant401JSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemGrant401ApplicationProblemPlusJSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteItemGrant403JSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemGrant403ApplicationProblemPlusJSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteItemGrant404JSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemGrant404ApplicationProblemPlusJSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant429JSONResponse struct{ N429JSONResponse }
+
+func (response DeleteItemGrant429JSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteItemGrant429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemGrant429ApplicationProblemPlusJSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteItemGrant500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteItemGrant500JSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteItemGrant500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteItemGrant500ApplicationProblemPlusJSONResponse) VisitDeleteItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrantRequestObject struct {
+	Id      uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Subject string `json:"subject" yaml:"subject" xml:"subject" bson:"subject"`
+	Body    *SetItemGrantJSONRequestBody
+}
+
+type SetItemGrantResponseObject interface {
+	VisitSetItemGrantResponse(w http.ResponseWriter) error
+}
+
+type SetItemGrant200JSONResponse Grant
+
+func (response SetItemGrant200JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant400JSONResponse struct{ N400JSONResponse }
+
+func (response SetItemGrant400JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant400ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant401JSONResponse struct{ N401JSONResponse }
+
+func (response SetItemGrant401JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant401ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant403JSONResponse struct{ N403JSONResponse }
+
+func (response SetItemGrant403JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant403ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant404JSONResponse struct{ N404JSONResponse }
+
+func (response SetItemGrant404JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant404ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant409JSONResponse struct{ N409JSONResponse }
+
+func (response SetItemGrant409JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant409ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant413JSONResponse struct{ N413JSONResponse }
+
+func (response SetItemGrant413JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant413ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant422JSONResponse struct{ N422JSONResponse }
+
+func (response SetItemGrant422JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant422ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant429JSONResponse struct{ N429JSONResponse }
+
+func (response SetItemGrant429JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetItemGrant429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant429ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetItemGrant500JSONResponse struct{ N500JSONResponse }
+
+func (response SetItemGrant500JSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetItemGrant500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response SetItemGrant500ApplicationProblemPlusJSONResponse) VisitSetItemGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistoryRequestObject struct {
+	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Params ListItemHistoryParams
+}
+
+type ListItemHistoryResponseObject interface {
+	VisitListItemHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListItemHistory200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
+
+	// Data List of items
+	Data []ItemHistory `json:"data" yaml:"data" xml:"data" bson:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path" yaml:"path" xml:"path" bson:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
+
+	// Total Total number of items
+	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
+}
+
+func (response ListItemHistory200JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory400JSONResponse struct{ N400JSONResponse }
+
+func (response ListItemHistory400JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory400ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory401JSONResponse struct{ N401JSONResponse }
+
+func (response ListItemHistory401JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory401ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory403JSONResponse struct{ N403JSONResponse }
+
+func (response ListItemHistory403JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory403ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory404JSONResponse struct{ N404JSONResponse }
+
+func (response ListItemHistory404JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory404ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory409JSONResponse struct{ N409JSONResponse }
+
+func (response ListItemHistory409JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory409ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory429JSONResponse struct{ N429JSONResponse }
+
+func (response ListItemHistory429JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemHistory429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory429ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemHistory500JSONResponse struct{ N500JSONResponse }
+
+func (response ListItemHistory500JSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemHistory500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemHistory500ApplicationProblemPlusJSONResponse) VisitListItemHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParentRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ReadItemParentParams
+}
+
+type ReadItemParentResponseObject interface {
+	VisitReadItemParentResponse(w http.ResponseWriter) error
+}
+
+type ReadItemParent200JSONResponse ItemParentRead
+
+func (response ReadItemParent200JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadItemParent400JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemParent400ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadItemParent401JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemParent401ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadItemParent403JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemParent403ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadItemParent404JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemParent404ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadItemParent409JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemParent409ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent429JSONResponse struct{ N429JSONResponse }
+
+func (response ReadItemParent429JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadItemParent429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemParent429ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReadItemParent500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadItemParent500JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemParent500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
 }
 
-type ListItemRequestObject struct {
-	Params ListItemParams
+func (response ReadItemParent500ApplicationProblemPlusJSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItemResponseObject interface {
-	VisitListItemResponse(w http.ResponseWriter) error
+type ReadItemRelationRequestObject struct {
+	Id    uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Other uint32 `json:"other" yaml:"other" xml:"other" bson:"other"`
 }
 
-type ListItem200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
+type ReadItemRelationResponseObject interface {
+	VisitReadItemRelationResponse(w http.ResponseWriter) error
+}
 
-	// Data List of items
-	Data []ItemList `json:"data" yaml:"data" xml:"data" bson:"data"`
+type ReadItemRelation200JSONResponse ItemRelation
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
+func (response ReadItemRelation200JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// LastPage Last page number
-	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
+type ReadItemRelation400JSONResponse struct{ N400JSONResponse }
 
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
+func (response ReadItemRelation400JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Path Base path of the request
-	Path string `json:"path" yaml:"path" xml:"path" bson:"path"`
+type ReadItemRelation400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
 
-	// PerPage Number of items per page
-	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
+func (response ReadItemRelation400ApplicationProblemPlusJSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
+type ReadItemRelation401JSONResponse struct{ N401JSONResponse }
 
-	// Total Total number of items
-	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
+func (response ReadItemRelation401JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ListItem200JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type ReadItemRelation401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response ReadItemRelation401ApplicationProblemPlusJSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItem400JSONResponse struct{ N400JSONResponse }
+type ReadItemRelation403JSONResponse struct{ N403JSONResponse }
 
-func (response ListItem400JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+func (response ReadItemRelation403JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItem404JSONResponse struct{ N404JSONResponse }
+type ReadItemRelation403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
 
-func (response ListItem404JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+func (response ReadItemRelation403ApplicationProblemPlusJSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadItemRelation404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadItemRelation404JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItem409JSONResponse struct{ N409JSONResponse }
+type ReadItemRelation404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
 
-func (response ListItem409JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+func (response ReadItemRelation404ApplicationProblemPlusJSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItem500JSONResponse struct{ N500JSONResponse }
+type ReadItemRelation429JSONResponse struct{ N429JSONResponse }
 
-func (response ListItem500JSONResponse) VisitListItemResponse(w http.ResponseWriter) error {
+func (response ReadItemRelation429JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateItemRequestObject struct {
-	Body *CreateItemJSONRequestBody
+type ReadItemRelation429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
 }
 
-type CreateItemResponseObject interface {
-	VisitCreateItemResponse(w http.ResponseWriter) error
+func (response ReadItemRelation429ApplicationProblemPlusJSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateItem200JSONResponse ItemCreate
+type ReadItemRelation500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateItem200JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+func (response ReadItemRelation500JSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateItem400JSONResponse struct{ N400JSONResponse }
+type ReadItemRelation500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
 
-func (response CreateItem400JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+func (response ReadItemRelation500ApplicationProblemPlusJSONResponse) VisitReadItemRelationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreItemRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type RestoreItemResponseObject interface {
+	VisitRestoreItemResponse(w http.ResponseWriter) error
+}
+
+type RestoreItem204Response struct {
+}
+
+func (response RestoreItem204Response) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreItem400JSONResponse struct{ N400JSONResponse }
+
+func (response RestoreItem400JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateItem409JSONResponse struct{ N409JSONResponse }
+type RestoreItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
 
-func (response CreateItem409JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+func (response RestoreItem400ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateItem500JSONResponse struct{ N500JSONResponse }
+type RestoreItem401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateItem500JSONResponse) VisitCreateItemResponse(w http.ResponseWriter) error {
+func (response RestoreItem401JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteItemRequestObject struct {
-	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
-	Params DeleteItemParams
+type RestoreItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
 }
 
-type DeleteItemResponseObject interface {
-	VisitDeleteItemResponse(w http.ResponseWriter) error
+func (response RestoreItem401ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteItem204Response struct {
+type RestoreItem403JSONResponse struct{ N403JSONResponse }
+
+func (response RestoreItem403JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteItem204Response) VisitDeleteItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type RestoreItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
 }
 
-type DeleteItem400JSONResponse struct{ N400JSONResponse }
+func (response RestoreItem403ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-func (response DeleteItem400JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreItem404JSONResponse struct{ N404JSONResponse }
+
+func (response RestoreItem404JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteItem404JSONResponse struct{ N404JSONResponse }
+type RestoreItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteItem404JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response RestoreItem404ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteItem409JSONResponse struct{ N409JSONResponse }
+type RestoreItem409JSONResponse struct{ N409JSONResponse }
 
-func (response DeleteItem409JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+func (response RestoreItem409JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteItem500JSONResponse struct{ N500JSONResponse }
+type RestoreItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteItem500JSONResponse) VisitDeleteItemResponse(w http.ResponseWriter) error {
+func (response RestoreItem409ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreItem429JSONResponse struct{ N429JSONResponse }
+
+func (response RestoreItem429JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreItem429ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreItem500JSONResponse struct{ N500JSONResponse }
+
+func (response RestoreItem500JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemRequestObject struct {
-	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
-	Params ReadItemParams
+type RestoreItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
 }
 
-type ReadItemResponseObject interface {
-	VisitReadItemResponse(w http.ResponseWriter) error
+func (response RestoreItem500ApplicationProblemPlusJSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItem200JSONResponse ItemRead
+type RevertItemRequestObject struct {
+	Id   uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+	Body *RevertItemJSONRequestBody
+}
 
-func (response ReadItem200JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+type RevertItemResponseObject interface {
+	VisitRevertItemResponse(w http.ResponseWriter) error
+}
+
+type RevertItem200JSONResponse ItemUpdate
+
+func (response RevertItem200JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItem400JSONResponse struct{ N400JSONResponse }
+type RevertItem400JSONResponse struct{ N400JSONResponse }
 
-func (response ReadItem400JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+func (response RevertItem400JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItem404JSONResponse struct{ N404JSONResponse }
+type RevertItem400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
 
-func (response ReadItem404JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+func (response RevertItem400ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem401JSONResponse struct{ N401JSONResponse }
+
+func (response RevertItem401JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
+
+func (response RevertItem401ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem403JSONResponse struct{ N403JSONResponse }
+
+func (response RevertItem403JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
+}
+
+func (response RevertItem403ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem404JSONResponse struct{ N404JSONResponse }
+
+func (response RevertItem404JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItem409JSONResponse struct{ N409JSONResponse }
+type RevertItem404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
 
-func (response ReadItem409JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+func (response RevertItem404ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem409JSONResponse struct{ N409JSONResponse }
+
+func (response RevertItem409JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItem500JSONResponse struct{ N500JSONResponse }
+type RevertItem409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
 
-func (response ReadItem500JSONResponse) VisitReadItemResponse(w http.ResponseWriter) error {
+func (response RevertItem409ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem413JSONResponse struct{ N413JSONResponse }
+
+func (response RevertItem413JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(413)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateItemRequestObject struct {
-	Id   uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
-	Body *UpdateItemJSONRequestBody
+type RevertItem413ApplicationProblemPlusJSONResponse struct {
+	N413ApplicationProblemPlusJSONResponse
 }
 
-type UpdateItemResponseObject interface {
-	VisitUpdateItemResponse(w http.ResponseWriter) error
+func (response RevertItem413ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertItem422JSONResponse struct{ N422JSONResponse }
+
+func (response RevertItem422JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateItem200JSONResponse ItemUpdate
+type RevertItem422ApplicationProblemPlusJSONResponse struct {
+	N422ApplicationProblemPlusJSONResponse
+}
 
-func (response UpdateItem200JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response RevertItem422ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateItem400JSONResponse struct{ N400JSONResponse }
+type RevertItem429JSONResponse struct{ N429JSONResponse }
 
-func (response UpdateItem400JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+func (response RevertItem429JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UpdateItem404JSONResponse struct{ N404JSONResponse }
+type RevertItem429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
 
-func (response UpdateItem404JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (response RevertItem429ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UpdateItem409JSONResponse struct{ N409JSONResponse }
+type RevertItem500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateItem409JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
+func (response RevertItem500JSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateItem500JSONResponse struct{ N500JSONResponse }
+type RevertItem500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
+}
 
-func (response UpdateItem500JSONResponse) VisitUpdateItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response RevertItem500ApplicationProblemPlusJSONResponse) VisitRevertItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItemChildrenRequestObject struct {
+type ListItemSiblingsRequestObject struct {
 	Id     uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
-	Params ListItemChildrenParams
+	Params ListItemSiblingsParams
 }
 
-type ListItemChildrenResponseObject interface {
-	VisitListItemChildrenResponse(w http.ResponseWriter) error
+type ListItemSiblingsResponseObject interface {
+	VisitListItemSiblingsResponse(w http.ResponseWriter) error
 }
 
-type ListItemChildren200JSONResponse struct {
-	// CurrentPage Page number (1-based)
+type ListItemSiblings200JSONResponse struct {
+	// CurrentPage Page number (1-based), 0 in cursor pagination
 	CurrentPage int `json:"current_page" yaml:"current_page" xml:"current_page" bson:"current_page"`
 
 	// Data List of items
@@ -694,15 +5090,18 @@ type ListItemChildren200JSONResponse struct {
 	// FirstPageUrl URL to the first page
 	FirstPageUrl string `json:"first_page_url" yaml:"first_page_url" xml:"first_page_url" bson:"first_page_url"`
 
-	// From Index (1-based) of the first item in the current page
+	// From Index (1-based) of the first item in the current page, 0 in cursor pagination
 	From int `json:"from" yaml:"from" xml:"from" bson:"from"`
 
-	// LastPage Last page number
+	// LastPage Last page number, 0 in cursor pagination
 	LastPage int `json:"last_page" yaml:"last_page" xml:"last_page" bson:"last_page"`
 
-	// LastPageUrl URL to the last page
+	// LastPageUrl URL to the last page, empty in cursor pagination
 	LastPageUrl string `json:"last_page_url" yaml:"last_page_url" xml:"last_page_url" bson:"last_page_url"`
 
+	// NextCursor Cursor of the next page in cursor pagination, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty" yaml:"next_cursor,omitempty" xml:"next_cursor,omitempty" bson:"next_cursor,omitempty"`
+
 	// NextPageUrl URL to the next page
 	NextPageUrl string `json:"next_page_url" yaml:"next_page_url" xml:"next_page_url" bson:"next_page_url"`
 
@@ -712,223 +5111,440 @@ type ListItemChildren200JSONResponse struct {
 	// PerPage Number of items per page
 	PerPage int `json:"per_page" yaml:"per_page" xml:"per_page" bson:"per_page"`
 
+	// PrevCursor Cursor of the previous page in cursor pagination, absent on the first page
+	PrevCursor *string `json:"prev_cursor,omitempty" yaml:"prev_cursor,omitempty" xml:"prev_cursor,omitempty" bson:"prev_cursor,omitempty"`
+
 	// PrevPageUrl URL to the previous page
 	PrevPageUrl string `json:"prev_page_url" yaml:"prev_page_url" xml:"prev_page_url" bson:"prev_page_url"`
 
-	// To Index (1-based) of the last item in the current page
+	// To Index (1-based) of the last item in the current page, 0 in cursor pagination
 	To int `json:"to" yaml:"to" xml:"to" bson:"to"`
 
-	// Total Total number of items
+	// Total Total number of items, 0 in cursor pagination
 	Total int `json:"total" yaml:"total" xml:"total" bson:"total"`
 }
 
-func (response ListItemChildren200JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings200JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItemChildren400JSONResponse struct{ N400JSONResponse }
+type ListItemSiblings400JSONResponse struct{ N400JSONResponse }
 
-func (response ListItemChildren400JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings400JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItemChildren404JSONResponse struct{ N404JSONResponse }
+type ListItemSiblings400ApplicationProblemPlusJSONResponse struct {
+	N400ApplicationProblemPlusJSONResponse
+}
 
-func (response ListItemChildren404JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (response ListItemSiblings400ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItemChildren409JSONResponse struct{ N409JSONResponse }
+type ListItemSiblings401JSONResponse struct{ N401JSONResponse }
 
-func (response ListItemChildren409JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings401JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListItemChildren500JSONResponse struct{ N500JSONResponse }
+type ListItemSiblings401ApplicationProblemPlusJSONResponse struct {
+	N401ApplicationProblemPlusJSONResponse
+}
 
-func (response ListItemChildren500JSONResponse) VisitListItemChildrenResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings401ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListItemSiblings403JSONResponse struct{ N403JSONResponse }
+
+func (response ListItemSiblings403JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemParentRequestObject struct {
-	Id uint32 `json:"id"`
+type ListItemSiblings403ApplicationProblemPlusJSONResponse struct {
+	N403ApplicationProblemPlusJSONResponse
 }
 
-type ReadItemParentResponseObject interface {
-	VisitReadItemParentResponse(w http.ResponseWriter) error
+func (response ListItemSiblings403ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemParent200JSONResponse ItemParentRead
+type ListItemSiblings404JSONResponse struct{ N404JSONResponse }
 
-func (response ReadItemParent200JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings404JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemParent400JSONResponse struct{ N400JSONResponse }
+type ListItemSiblings404ApplicationProblemPlusJSONResponse struct {
+	N404ApplicationProblemPlusJSONResponse
+}
 
-func (response ReadItemParent400JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (response ListItemSiblings404ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemParent404JSONResponse struct{ N404JSONResponse }
+type ListItemSiblings409JSONResponse struct{ N409JSONResponse }
 
-func (response ReadItemParent404JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings409JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemParent409JSONResponse struct{ N409JSONResponse }
+type ListItemSiblings409ApplicationProblemPlusJSONResponse struct {
+	N409ApplicationProblemPlusJSONResponse
+}
 
-func (response ReadItemParent409JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response ListItemSiblings409ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadItemParent500JSONResponse struct{ N500JSONResponse }
+type ListItemSiblings429JSONResponse struct{ N429JSONResponse }
 
-func (response ReadItemParent500JSONResponse) VisitReadItemParentResponse(w http.ResponseWriter) error {
+func (response ListItemSiblings429JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemSiblings429ApplicationProblemPlusJSONResponse struct {
+	N429ApplicationProblemPlusJSONResponse
+}
+
+func (response ListItemSiblings429ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListItemSiblings500JSONResponse struct{ N500JSONResponse }
+
+func (response ListItemSiblings500JSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreItemRequestObject struct {
-	Id uint32 `json:"id"`
+type ListItemSiblings500ApplicationProblemPlusJSONResponse struct {
+	N500ApplicationProblemPlusJSONResponse
 }
 
-type RestoreItemResponseObject interface {
-	VisitRestoreItemResponse(w http.ResponseWriter) error
+func (response ListItemSiblings500ApplicationProblemPlusJSONResponse) VisitListItemSiblingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreItem204Response struct {
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List Items
+	// (GET /)
+	ListItem(ctx context.Context, request ListItemRequestObject) (ListItemResponseObject, error)
+	// Create a new Item
+	// (POST /)
+	CreateItem(ctx context.Context, request CreateItemRequestObject) (CreateItemResponseObject, error)
+	// Stream Item change events
+	// (GET /events)
+	StreamItemEvents(ctx context.Context, request StreamItemEventsRequestObject) (StreamItemEventsResponseObject, error)
+	// List Webhooks
+	// (GET /webhooks)
+	ListWebhook(ctx context.Context, request ListWebhookRequestObject) (ListWebhookResponseObject, error)
+	// Create a new Webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Deletes a Webhook by ID
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Find a Webhook by ID
+	// (GET /webhooks/{id})
+	ReadWebhook(ctx context.Context, request ReadWebhookRequestObject) (ReadWebhookResponseObject, error)
+	// Updates a Webhook
+	// (PATCH /webhooks/{id})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// List dead letters of a Webhook
+	// (GET /webhooks/{id}/dead-letters)
+	ListWebhookDeadLetter(ctx context.Context, request ListWebhookDeadLetterRequestObject) (ListWebhookDeadLetterResponseObject, error)
+	// Deletes a Item by ID
+	// (DELETE /{id})
+	DeleteItem(ctx context.Context, request DeleteItemRequestObject) (DeleteItemResponseObject, error)
+	// Find a Item by ID
+	// (GET /{id})
+	ReadItem(ctx context.Context, request ReadItemRequestObject) (ReadItemResponseObject, error)
+	// Updates a Item
+	// (PATCH /{id})
+	UpdateItem(ctx context.Context, request UpdateItemRequestObject) (UpdateItemResponseObject, error)
+	// List of subordinate items
+	// (GET /{id}/children)
+	ListItemChildren(ctx context.Context, request ListItemChildrenRequestObject) (ListItemChildrenResponseObject, error)
+	// Copies a Item with its descendants
+	// (POST /{id}/copy)
+	CopyItem(ctx context.Context, request CopyItemRequestObject) (CopyItemResponseObject, error)
+	// List grants of an Item
+	// (GET /{id}/grants)
+	ListItemGrant(ctx context.Context, request ListItemGrantRequestObject) (ListItemGrantResponseObject, error)
+	// Revokes the grant of a subject on an Item
+	// (DELETE /{id}/grants/{subject})
+	DeleteItemGrant(ctx context.Context, request DeleteItemGrantRequestObject) (DeleteItemGrantResponseObject, error)
+	// Grants a subject permission on an Item
+	// (PUT /{id}/grants/{subject})
+	SetItemGrant(ctx context.Context, request SetItemGrantRequestObject) (SetItemGrantResponseObject, error)
+	// List change history of an Item
+	// (GET /{id}/history)
+	ListItemHistory(ctx context.Context, request ListItemHistoryRequestObject) (ListItemHistoryResponseObject, error)
+	// Find the attached Item
+	// (GET /{id}/parent)
+	ReadItemParent(ctx context.Context, request ReadItemParentRequestObject) (ReadItemParentResponseObject, error)
+	// Find how two Items relate
+	// (GET /{id}/relation/{other})
+	ReadItemRelation(ctx context.Context, request ReadItemRelationRequestObject) (ReadItemRelationResponseObject, error)
+	// Restore a trashed record
+	// (POST /{id}/restore)
+	RestoreItem(ctx context.Context, request RestoreItemRequestObject) (RestoreItemResponseObject, error)
+	// Reverts a Item to a previous revision
+	// (POST /{id}/revert)
+	RevertItem(ctx context.Context, request RevertItemRequestObject) (RevertItemResponseObject, error)
+	// List of sibling items
+	// (GET /{id}/siblings)
+	ListItemSiblings(ctx context.Context, request ListItemSiblingsRequestObject) (ListItemSiblingsResponseObject, error)
 }
 
-func (response RestoreItem204Response) VisitRestoreItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
 }
 
-type RestoreItem400JSONResponse struct{ N400JSONResponse }
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
 
-func (response RestoreItem400JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListItem operation middleware
+func (sh *strictHandler) ListItem(ctx *gin.Context, params ListItemParams) {
+	var request ListItemRequestObject
 
-	return json.NewEncoder(w).Encode(response)
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListItem(ctx, request.(ListItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ListItemResponseObject); ok {
+		if err := validResponse.VisitListItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RestoreItem404JSONResponse struct{ N404JSONResponse }
+// CreateItem operation middleware
+func (sh *strictHandler) CreateItem(ctx *gin.Context) {
+	var request CreateItemRequestObject
 
-func (response RestoreItem404JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body CreateItemJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateItem(ctx, request.(CreateItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(CreateItemResponseObject); ok {
+		if err := validResponse.VisitCreateItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RestoreItem409JSONResponse struct{ N409JSONResponse }
+// StreamItemEvents operation middleware
+func (sh *strictHandler) StreamItemEvents(ctx *gin.Context, params StreamItemEventsParams) {
+	var request StreamItemEventsRequestObject
 
-func (response RestoreItem409JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamItemEvents(ctx, request.(StreamItemEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamItemEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(StreamItemEventsResponseObject); ok {
+		if err := validResponse.VisitStreamItemEventsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RestoreItem500JSONResponse struct{ N500JSONResponse }
+// ListWebhook operation middleware
+func (sh *strictHandler) ListWebhook(ctx *gin.Context, params ListWebhookParams) {
+	var request ListWebhookRequestObject
 
-func (response RestoreItem500JSONResponse) VisitRestoreItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhook(ctx, request.(ListWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ListWebhookResponseObject); ok {
+		if err := validResponse.VisitListWebhookResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(ctx *gin.Context) {
+	var request CreateWebhookRequestObject
+
+	var body CreateWebhookJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWebhook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List Items
-	// (GET /simple-tree)
-	ListItem(ctx context.Context, request ListItemRequestObject) (ListItemResponseObject, error)
-	// Create a new Item
-	// (POST /simple-tree)
-	CreateItem(ctx context.Context, request CreateItemRequestObject) (CreateItemResponseObject, error)
-	// Deletes a Item by ID
-	// (DELETE /simple-tree/{id})
-	DeleteItem(ctx context.Context, request DeleteItemRequestObject) (DeleteItemResponseObject, error)
-	// Find a Item by ID
-	// (GET /simple-tree/{id})
-	ReadItem(ctx context.Context, request ReadItemRequestObject) (ReadItemResponseObject, error)
-	// Updates a Item
-	// (PATCH /simple-tree/{id})
-	UpdateItem(ctx context.Context, request UpdateItemRequestObject) (UpdateItemResponseObject, error)
-	// List of subordinate items
-	// (GET /simple-tree/{id}/children)
-	ListItemChildren(ctx context.Context, request ListItemChildrenRequestObject) (ListItemChildrenResponseObject, error)
-	// Find the attached Item
-	// (GET /simple-tree/{id}/parent)
-	ReadItemParent(ctx context.Context, request ReadItemParentRequestObject) (ReadItemParentResponseObject, error)
-	// Restore a trashed record
-	// (POST /simple-tree/{id}/restore)
-	RestoreItem(ctx context.Context, request RestoreItemRequestObject) (RestoreItemResponseObject, error)
-}
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(ctx *gin.Context, id uint32) {
+	var request DeleteWebhookRequestObject
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	request.Id = id
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhook")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListItem operation middleware
-func (sh *strictHandler) ListItem(ctx *gin.Context, params ListItemParams) {
-	var request ListItemRequestObject
+// ReadWebhook operation middleware
+func (sh *strictHandler) ReadWebhook(ctx *gin.Context, id uint32) {
+	var request ReadWebhookRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListItem(ctx, request.(ListItemRequestObject))
+		return sh.ssi.ReadWebhook(ctx, request.(ReadWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListItem")
+		handler = middleware(handler, "ReadWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		handleErrorResponse(ctx, err)
-	} else if validResponse, ok := response.(ListItemResponseObject); ok {
-		if err := validResponse.VisitListItemResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ReadWebhookResponseObject); ok {
+		if err := validResponse.VisitReadWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -936,11 +5552,13 @@ func (sh *strictHandler) ListItem(ctx *gin.Context, params ListItemParams) {
 	}
 }
 
-// CreateItem operation middleware
-func (sh *strictHandler) CreateItem(ctx *gin.Context) {
-	var request CreateItemRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(ctx *gin.Context, id uint32) {
+	var request UpdateWebhookRequestObject
 
-	var body CreateItemJSONRequestBody
+	request.Id = id
+
+	var body UpdateWebhookJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -949,18 +5567,45 @@ func (sh *strictHandler) CreateItem(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateItem(ctx, request.(CreateItemRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateItem")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		handleErrorResponse(ctx, err)
-	} else if validResponse, ok := response.(CreateItemResponseObject); ok {
-		if err := validResponse.VisitCreateItemResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWebhookDeadLetter operation middleware
+func (sh *strictHandler) ListWebhookDeadLetter(ctx *gin.Context, id uint32, params ListWebhookDeadLetterParams) {
+	var request ListWebhookDeadLetterRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeadLetter(ctx, request.(ListWebhookDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeadLetter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ListWebhookDeadLetterResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeadLetterResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1083,11 +5728,161 @@ func (sh *strictHandler) ListItemChildren(ctx *gin.Context, id uint32, params Li
 	}
 }
 
+// CopyItem operation middleware
+func (sh *strictHandler) CopyItem(ctx *gin.Context, id uint32) {
+	var request CopyItemRequestObject
+
+	request.Id = id
+
+	var body CopyItemJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CopyItem(ctx, request.(CopyItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CopyItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(CopyItemResponseObject); ok {
+		if err := validResponse.VisitCopyItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListItemGrant operation middleware
+func (sh *strictHandler) ListItemGrant(ctx *gin.Context, id uint32) {
+	var request ListItemGrantRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListItemGrant(ctx, request.(ListItemGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListItemGrant")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ListItemGrantResponseObject); ok {
+		if err := validResponse.VisitListItemGrantResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteItemGrant operation middleware
+func (sh *strictHandler) DeleteItemGrant(ctx *gin.Context, id uint32, subject string) {
+	var request DeleteItemGrantRequestObject
+
+	request.Id = id
+	request.Subject = subject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteItemGrant(ctx, request.(DeleteItemGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteItemGrant")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(DeleteItemGrantResponseObject); ok {
+		if err := validResponse.VisitDeleteItemGrantResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetItemGrant operation middleware
+func (sh *strictHandler) SetItemGrant(ctx *gin.Context, id uint32, subject string) {
+	var request SetItemGrantRequestObject
+
+	request.Id = id
+	request.Subject = subject
+
+	var body SetItemGrantJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetItemGrant(ctx, request.(SetItemGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetItemGrant")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(SetItemGrantResponseObject); ok {
+		if err := validResponse.VisitSetItemGrantResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListItemHistory operation middleware
+func (sh *strictHandler) ListItemHistory(ctx *gin.Context, id uint32, params ListItemHistoryParams) {
+	var request ListItemHistoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListItemHistory(ctx, request.(ListItemHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListItemHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ListItemHistoryResponseObject); ok {
+		if err := validResponse.VisitListItemHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ReadItemParent operation middleware
-func (sh *strictHandler) ReadItemParent(ctx *gin.Context, id uint32) {
+func (sh *strictHandler) ReadItemParent(ctx *gin.Context, id uint32, params ReadItemParentParams) {
 	var request ReadItemParentRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.ReadItemParent(ctx, request.(ReadItemParentRequestObject))
@@ -1109,6 +5904,33 @@ func (sh *strictHandler) ReadItemParent(ctx *gin.Context, id uint32) {
 	}
 }
 
+// ReadItemRelation operation middleware
+func (sh *strictHandler) ReadItemRelation(ctx *gin.Context, id uint32, other uint32) {
+	var request ReadItemRelationRequestObject
+
+	request.Id = id
+	request.Other = other
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadItemRelation(ctx, request.(ReadItemRelationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadItemRelation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ReadItemRelationResponseObject); ok {
+		if err := validResponse.VisitReadItemRelationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RestoreItem operation middleware
 func (sh *strictHandler) RestoreItem(ctx *gin.Context, id uint32) {
 	var request RestoreItemRequestObject
@@ -1135,36 +5957,158 @@ func (sh *strictHandler) RestoreItem(ctx *gin.Context, id uint32) {
 	}
 }
 
+// RevertItem operation middleware
+func (sh *strictHandler) RevertItem(ctx *gin.Context, id uint32) {
+	var request RevertItemRequestObject
+
+	request.Id = id
+
+	var body RevertItemJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevertItem(ctx, request.(RevertItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevertItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(RevertItemResponseObject); ok {
+		if err := validResponse.VisitRevertItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListItemSiblings operation middleware
+func (sh *strictHandler) ListItemSiblings(ctx *gin.Context, id uint32, params ListItemSiblingsParams) {
+	var request ListItemSiblingsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListItemSiblings(ctx, request.(ListItemSiblingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListItemSiblings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		handleErrorResponse(ctx, err)
+	} else if validResponse, ok := response.(ListItemSiblingsResponseObject); ok {
+		if err := validResponse.VisitListItemSiblingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3W7buBJ+FYLnXLSAGrtpeg7iu7bBAgaCIsi22IsiSMfi2GIhkSpJJTEKv/tiSMqW",
-	"Lfkn27S76voiiC2NhjMfvxnOjPWNp7ootULlLB994wZtqZVF/+VsOKR/qVYOlaOPUJa5TMFJrQZfrFZ0",
-	"zaYZFkCfSqNLNE6Gp1MtkP67eYl8xKVyOEPDFwlHY7QhmUXCrQNX2YacdUaqGV8sEm7wayUNCj76FLQt",
-	"xW+SWlxPvmDq+ILkBdrUyJKs8wveQS4Fk6qsXMIEOGDxGhlxNjzrsXMGra5Mikxpx6a6UtGn8x77lGo1",
-	"zWXqpJqx2j9Ly7/uNQ8rhQ8lpg4F8wt6lcFYv97YYdFhdSZzYdB7Jh0W/uJ/DU75iP9nsAraQdQ08GoW",
-	"S3vAGJjT99QgOBS34IGbalPQJy7A4QsnC3Jmw+OES7EmW0nlXp3yhBfwIIuq4KOz0/Oz8//9//T8dcIL",
-	"qcLFl0kHxgoKj/46JmQs87e80ktUM5fx0enroG/5vcO2EkwkwSFoBOnb4NC6DVf+FjOYaiPY+IInT+Nx",
-	"VYpHAr5BMSl4xK1Nr8RD987vaQdpfsm9/tV271Jad9y7fu7dNYJ4mr0TmOPqmXWALsAhAyUYPczuM1TM",
-	"ZVgDdg+WxaebyDVXU1WewyRHPnKmwiNz/gHM+eiXOMZ9P3fvNtj+dOF/3MKft4UkK9VUt935kEnLpGWg",
-	"2JurMcul9d1HJtGASTOZQs6cQfTdI9kgHSVV/rssyhzDrfqhN1djnvA7NDboHp4MT16Sb7pEBaXkI/7q",
-	"ZHjyihPALvPMGViv6AUpou8z7DgNqF5gtJ32hHttxjc+YxHv+VrX7xoU6JA6mk+bOu4zcKyEGTKnmUEl",
-	"0HAChY/41wrNvAZvxEmIJ41uatfGLZLNhahXYamulFutxEr6C3o7l0Rz2162JlHN2EcY8R4KZHrqD00Z",
-	"0OlaN0bH2pqHx0l72T8ydBkaclyqNK8EMQRshoKFFq7bjCizZklca6J1jqD4YnGTrM9mTr+vJ66MD3GP",
-	"ekeQz5CpqpigYc9evpiARfGc7wthHyLd5NXTFQCH9rK+Su7oZ6fS2GD4bWXy9oIfry9pA2jrvWhNvFaW",
-	"mxpddKRNJfBh5XXNoqDKk1uGYixCWKtfYjPswiYHuw3sS4g2RsT34rzUtdf/HHa4r/DhQDUkuVUNpbL2",
-	"02/BIqNbNX6UqtG6Tg119Le0vA8UrNnTTCO7ISoN3h3mG0lKXdmt/jl9MEc83H+ZIk476LD1A12ug7ER",
-	"R7uUbY6smsFeL5Q0s+6KnjEsvN+tWNvk3iaJNoGP7Iip4ZBZ2RXMpKJCwJ+qK3/9bHO4LW0sE+OAhFaz",
-	"3X2yZ42Z6T7Z88YgcrcsCfkRX1UUYOZrBzgBCzM6oMPY74bIqm3HoR9GPJYBU3jvH/YNYUnlhXWWSX++",
-	"WqcNzLBdF4THY2UQg++tFvNHnRkghKRbkF81To8p5BaTjQOl/zXoRtDsKCU7fHSahSaAN7VQ7734znN7",
-	"3zEZR4HbDIutyeMD6IcHRTC8we92bCyStQJ58E2KRaBDjq6Dbhf+uvW513t/L13WPH6Q6NIOlvDcIWX0",
-	"+KJO9uNGWRmzXCznfCeyToJmZfckVP17K8+zLZHu4W5CvTaq6ncOr7kFgVmTeUg8rWze2cH9JpXYR0uf",
-	"3g26yijK7m2WXiOII0d/THe0L8v6uc+2HNtN+8aPsr0lPfF2L+NLcGlHCxDmncuAWSte0gzUjBL1jgom",
-	"PN8jwt8cC61DC63DiqqV40SUMCH86fVVHNtvszHOLXse5+uxelghNmi+obB9bgnOQUo5vhbfPsN8Vyvs",
-	"6QH3HTPWnzhX7cP0NJRBDPKckQwqAcpZ9sxgWhnr8fXDlhxh+nyLeVH2OFU9TlWPU9XjVPU4VW1OVW01",
-	"0Ub4i8H3ExaFpFZMWiakhUmOIrx88jkm0890i87Sk19hItuFw4G1z+olxK39vufqsvrxxWKjalnNAGby",
-	"DlUorrv7/VCF96kJ+oHlePM9jG01+RJ0p9m/oUFvEe1AEhuk5ju8ktT5G8R1EGBQt4COKlzCrk7w+ZxZ",
-	"PXWN19E2Oew19K2L3zftvI4v4nXyKsLad2qtNr+e0gUSUNgt/gwAAP//Tp4FHDcyAAA=",
+	"H4sIAAAAAAAC/+w9f3PbNrJfBcN3M+86Rzu2k7YX/5dr+sPXvJ7HyU3eTCfPgsiVhCtFsABoWdPxd3+z",
+	"C5CERJCiHdWxEv6TWCQILBa7i/0J/BElclnIHHKjo/M/IgW6kLkG+vHi5AT/S2RuIDf4Jy+KTCTcCJk/",
+	"+4+WOT7TyQKWHP8qlCxAGWG/TmQK+L9ZFxCdRyI3MAcV3cURKCUVtrmLI224KbXXThsl8nl0dxdHCn4v",
+	"hYI0Ov/V9lY3/xBXzeX0P5AYau4DVyg5zWD5tzaQf1Ewi86j/3rWTPyZfaufXdqvojvsLgWdKFFgdwT+",
+	"Dc9EykRelCZmKTecuWc4pRcnpyOqHKqWQmuRz5lUFYZYoiCF3AieaYut5yO2asLS5WwmEgG5YQUowp7M",
+	"HZ5ejHhyeFKgZakSYLk0bCbL3PHdyxFDDkOJzGeZSAzyXoUtS0anI7s1ZPR7CdqwqUzXzEjJMq7mQFg6",
+	"OxuxtIUlBTNQmhlpNzyz4IalEnT+34bBrdDGIm7kwRpx3ADLxFIgfhKAFNIojhbAU6CpRFdg1Pro1cyA",
+	"wp+bX7+FROYp4XvFhWFTmEkFTOE3ONvYA3ApcrEsl9H5adzCHQH29ai91Ygtc7gtIDGQMgKfRnTfYbff",
+	"lUrLwIL8q+C/l8AKqQU+YXLGOCv4HJjIWUIf4U+RE9xRHC357RvI52YRnZ99/XWMq1T9Po23kRRHPyqe",
+	"mwDyFXAD6TWndzOplvhXlHIDR0YsIQp0JdKNtqXIzfMzC5GlkxdnL1+8/Obbs5cWrE7iiSNhYHkt0jY6",
+	"Ll4jBswCGDZhc4SeCHwvwzbKz86VblriOpaWitrsZF9UcMaMa8ZLs4DcIKVBev8FK4v0niuzxQkCB60Q",
+	"3MC+Mfs2f8TRhYFlgFAWIksVEMKwU70Lc9TNXd0/V4qv8fcnpricLyFAbkhk9Kp3nc4CsBVcOaE3BBu2",
+	"dZDkL+kVU5BIlbKL1/si9v0QEiGni1y+k8W6PaEr0GVmkI8TWeCuwnjOCNMrYRZMGM3wA8hTnhsdxVsU",
+	"1y8WsMvqb0JuCFsBgZNS3zxNSczy7HJjzCFdbEOk3QwF6Jj9BmtI2XTN3PMKPG1bpFFoQ2pzbaq7cU38",
+	"8+Qk+Z/EV58bp3yfzgNowqdILVawxhHkCOOvlWyJG+kbRzxPQBupfAppsIZj/CAgC2CNHodGaaD2sb4h",
+	"qTdwgyyQgfvRBcRPAoFct+mUJ8btu9X4dpx6jLr/CJGLvRBgpZrbJzegwsPyxFjNqvVmT7zxzYtoDwpN",
+	"suD5HFJahn1RLq7eNa9U/dZc6LVV8YPv3arzsK3g2O3iNaMG3iT2po/Z8RsIuwCwLf4ECJwZ6pauhSAF",
+	"N6JSF7c3OfuG5eVyCsrXWmOmDVfkJJkpuWSn+wG2X82rIY0rTtsg/y659EbogHXQyJrAvHnKpxmwus3m",
+	"1JVE55lQ2sRMzBjcFjy3Jupg7fGNs/pbGqSnim4C9Z17swnKvod/gDDxBGYL6NfcIBZThh+z1QJygt3t",
+	"biuumfs6isOj5WWW4VJE50aVcLDqM8+yf82i81+HrsyHOCwmutaecKwc2X6+GgYy5sjJIyePnHz4nJxx",
+	"E1Q6fpIrZlbSWZYK25GfELFlFEDLlq5Yu93V+wWYhVPqaPGFRjO9+qBaA4mNmjlNpcyA55U5bE34gZ17",
+	"Rv+A3oU2CEu7719qfQvSOWgm7fQLbhZsCmYFkNfj6pjxqUY6EDTgmnEFrMwt5tIOT0GfEn8Pl0OW8L0w",
+	"xRu5Am1YIpdL2SzQ1swcSWxML6b4uEHkY0TTZxyL+J55UoP7zBbx37PHWPD8tSLVuCaQssCQBP7MgtMl",
+	"1k/lKq+aEYAxm0r0KOVJVmpxA8fs+2Vh1oiTGgvH+9gxXF/dlE5wNGvgeFLzZc2Y2zQeEgsVPzT0WTPw",
+	"BsM5dHcJkH9be3p0FR2k+L+udKCwbTYu4kEsooU9rJSP+udIQH0EdLkRptyaUv2uCTpOVkoYmNh9MAXN",
+	"JrjXT2KiiQlPlyL3XuJW5XmBsWkUR9RFFEfUOuhmrYLfbZDsC5aC4SIjK5LnNgpOAdEUZiK30ZGrH75j",
+	"3/795NuWomq/DXjIb4uM29h3rZokSakU5ElY1OVdiuMlah2uD+f2C3dACYVHBVd8qYM0xZdgAM1lxZpZ",
+	"sBkXGfr86PMqWl9rHpvTFYGVxS09Zr+XoNYxs/kcOAKmEYXgDPNRDR0xE2mAqTRMA07HQMoKDwsOpmD3",
+	"CrgO0d97TNARmq2UzOftD7fo3PUSIvJtFatJ9Niyed69u2T2JXNJHm2GNMJkEArPL5dcrb35EqHS94E5",
+	"2wfbnfz76iLUQcxSmZRLQBZEfe/q+1ev/+f7nRipxiaAe/JV4uid4nrRp3Ma24CJWu1EXj5mk9OJ5XwU",
+	"5hN6kUKhgBISGM8E10BcOsF47CRmkxP3wYxnGib1K1maybEnKtwzlBaCZIjMMyQedHDjWFEcnaCIxV6C",
+	"IuQ9TBdS/hYODt34MQrPFHzIbgk3Vbr5ljih55RtBpm4ARRQOdHHxA6D2LDSHf9ayhv6326fDkkuNjU5",
+	"Zq+yjNmRHJKpS1yRGQM0RHwB0EFtDQPscY/VkCgwwewvBYaVGlLEgRbzyiBbZ5Kneke2yjd7SVeJo1Jl",
+	"ITZ7gxs1iBsUogiVW0R/z1ZiC8STF3/fkVET2nkRgBpLcUV9ISZ0FNsVfB/pdq90e8DEtJuGXls0h0Lj",
+	"xiDaNzNR6rhzLxpPQmh8MOUF1xtuutTrxl1kv47vHzZ/ULA949pc2yzLEMA53Jprh9N74cCJwWCnvnoy",
+	"42WGnRWQpyKfeztk86RmKvqbp8H98CH0vrLUtGNBXCtPYjAj/7w4tAeURzDuz6jBbI3HuCH69oJ1p6D0",
+	"sFdHJHsU0KOAHiigOyKoIwWNFDSQgroc7yMNjTS0g4as1VYqYdZvMSDlCKcQP8P6VRmKrb013IiEvbq8",
+	"wOxjXAt8ah1HlXfxPPrfo1eXF0c/g+fksZ0iUqbAFaiqe/vrh2ri/3z/LtqORf7z/TuyGiG1udw/vT37",
+	"+ht0Ml3hH1X1EFE4ddYMujCmsHUzIp/J9mzeLYR2MWicUSY0JdAtBCiukoVIeEaRNCoTqx0o59FbsSwy",
+	"G2SrP3p1eRHF0Q0o60KNTo5Pjk9xvrKAnBciOo+eH58cP3dxNML0M/xnHjKaUbGwoT30hSBbk3PvIo3O",
+	"IxzRBUmL2i9Iwd7NPlboNaOqHiOZgtwuES0YOfya9cJGw6uw4u2BqGomkWVumpFYAYq5foNDgrpuD1ux",
+	"ZeUFuAcQv/Al+EkcHePSf1tjDnf8t4e9rMuk2FKmEDOnqZNAnMjZTIOZHLOJraqaeGVVLAdBLjXCHIbL",
+	"FdO/iUIz4bII8pSJeS4VOtcRV5Pu5WtKtZqZVfaBBSKKIwtD9GHAtFyFmP3Chs0npDFX85CKTQoFN80D",
+	"qiFTcCNkqWnlY4ZsQrUYoZqy0EwchPHA0jhX3xaAH9WqykepbRbGChQwbvAHm4sbyClyFVOgJtdGlVRH",
+	"R1Mlgl64pPIwpFxfy9kGoMMiMq3cM7lccs9VPhOQ2VJJFzuJ2USkbpvEsa1vlWcrvtZVm9Tum+5bmwtg",
+	"X+C2KZfCuJwEuC0yqoAkd2l4ZraXjakNTmWw1QDbG3Eclbn4vYQL241RJZBxuyZhiniLdiPGJt4YyWA5",
+	"tc5vguqY/YKhhrIopDLVDjGh1ZkMnbFN9XrYjKnGYi8T9ki2doTX7naZg2Z/dbLlq9h50TdeU9xlo/0E",
+	"XeVdQsO1HMxrVWTgDpOFNs5TOfu4ilyKqxm7GQRCS3Oost3/eno05RrSr2J20lmo2uujom08vNd6pSof",
+	"nbhD+as0oetODdBlFFHTap9s6Y8ojNqfX+Qp3DbYqDY92xWJLpcJ5FDrhPEDcUZ+r/DivOEOdrdCHz/G",
+	"Tnxl1ZCxtQa6xgv755KOemi7j1SIxKbd1dB1DpzMNyHqHHXQxOpBwz7CkC7+D65hI7TaE2Cu1a2e1EYr",
+	"ezy9bUcdTbP778LohlowCKv9fEFDD0LrxsihrowczGC00nvnLyMND8zhHT726nycTvigQbZPLfAlbgVA",
+	"7GvkDc87GYStopZg22bcbXrfXihHyE4OB8zRuzisXUNKZlYjpOmInJMuGV3vTs+wUXOM1a62p94hTrva",
+	"PvcOMtrV9oV3pM+uti+9o0d2tD17GTXnYfS3xUZk7dvchA0DExeXz9GAtNX4H5DBpA4YpTYgiVnVOazo",
+	"Y1JLC1BaaIN2CwV4jVR8Dm271XqDnOXqZNU/ZLq+l/IQLqZ2yt2mZnH4SV9bjNuRu9VmG5qjkawudm16",
+	"cbroRylwu/QiSyedgFmo0qfDxPdgzNMh/Z4+9w5e2sXEZ4/E8HZRPN5t8/1dHD1rvLxBv9Rbo4CjhXJv",
+	"127l1ZWzKmVds7egbkAdvUXWsW7kY/Y9Txa2LUu4UgLIPXfxOmarhUgWLOE5mwIjZWHKk9+qzXiC2ugR",
+	"9XJ08XpSJbORQ0qXS1vHqwl8V1pMhn8OdLJYW1rZpmTiVe7ZXm9bEwYlPcHOwPp5bQFZyFG6AfOGKVZw",
+	"Y0DhN//368nRyw9/+0vAm7DbEjNwa+yaHtkJbXLydod3cXC9q0VzddBuKZ8MA//5zOPQEMBBkIlcZFr3",
+	"u3dd/KbDw+veftFO3k/uadhpBO3Ro+CnFByIU2GfzoNhCTj3cxJ8kSb507KL92r/fkF2br2FjKbuXkzd",
+	"arP1Nmz3aLDB69o/wOZt9vJHMHv78j4OMYfjMfPKP2G+xkZ6+BCZ8b7JtvwEdv5mjnoPeKO1/8mt/Ub8",
+	"tEWfb648+0Okd5YBkK8DNZ/0nOLptTSkgKun6gC6v9qC0HY50KhpbOnmA1GVqzXmhUhbJB8MyH+EA65t",
+	"gbwI1Eb5qPDRsFEGO27kH0vUFfnxmvjo7MuOLT1oeP8g6CDqXfTrDn0xpcpxi2+TswKefgbEvPf9gNKZ",
+	"e3aDMIt49x6MDPIRDILUPZA7Cm6SgAFrk4l9FtvQdq3vS/eqvFZNPEzmGJXzUTmvlPPBCnizwjjV+lzZ",
+	"R9fFLe/2QWph+/wl7eHq7S35O0xpf5YCT48yMMbdx9EZddAV89OlJ4kssxRvPZn6ksD5COsdgKJlPMtY",
+	"VT4XM5mloN1Jjb3xi9fA0zcE1+FsBfEYZPnMgyx1WfQYaBkDLWOg5UsOtODOyaqdczRB9xJs8XFqC4N2",
+	"aTP38Tw2t7oMdTsOqZZrHbf6RHSP+uCluiJo8wyme5R3tE4DHeTdbNA9ujYfwbVJ6N723NSJuTucmt2s",
+	"Mcijech8Mlb8jRV/Y8Xf06z424XSrqhBz9Yzhgz2GjLo33V2BgvatSDDIwUHtOl8GOtWBu7OA2tUPrnr",
+	"3Du5vgvG0Wl+OE7z7roW8pL7VwB15+VzY3iCW2rVvPsIlurU/ENVmj/Csf2Izuwne/iLZ5tb04qiJN49",
+	"s+yvCrBal/BL7rsM+OyrDvBc236bfTyBZjyBZrRHR3t0tEfHE2jGE2jGE2jGE2jGE2jGE2geMWCsy6lU",
+	"KT2sFABPBReapULjvVapvRRr4rT6Cb7Cffh49FruJ8ocWos+418W9hqEcKmfLIQfXybTx2jfmIsZ3CZQ",
+	"mC2dpyS7ttHprbcNuUTmFKvNtb0cPVAdKIv16P5s3cpVhPaaN3AD9p4v377G4jdZrFEsJVsriKqntUr8",
+	"D7gC23LbMOmXkbtdqG6BLDT2qBK6gJZutG6G2tdtcLqczcRtG5pXRQF0bXG1q3ueEYTNJ6SYTUtD17Ru",
+	"UXrAxfyQ/OgF2bt5yhZyVa2UT8W73Lyn+z2VCPm/80wiIonRx/t0Cxotd3MvxaFNtF2iny4t3JUXbRs1",
+	"3l/HQDSeMBqyGW0AWZnaUgOpgYl8AUp4XhvdXGzf7TX+UVX36R6IzP8IU3uQKWsR0rJj27z6o10jHzGH",
+	"yLKPpCDNa2zx3CKrYRGL8haPPPtDlyTQt7LxurLqDomU4/ZNjTTV5k5TrhkvzQJyIxJ3F3YAVIehXnj7",
+	"ipuGnOMVyMMjVDMFN/I3SEeyD5L9FWHHKoG0qjYo4FaM0d3uXZwQR0XZcwaIVExBkfFku3v84Q3QKFe1",
+	"EtjsEtN1K0LkcUZ7x9BgRi7bK5f96eZLsXGPct+u5924vO0g8ToZ5KSom6PWMnfU8nhZFG7/7tivt5hk",
+	"VLKfrJLt1KtGYDZ02C87ay2iCln2q9pVctiSp+Dr2UPqCrHdT3Vg9EvLuhjLCZ9UOaFPjGMp4VhKOJYS",
+	"fsmlhG5bG+M7+3FfWHRWWVBhN8a2p8/66TvVD8p2R3KvvXw2VDALVE/ZaA6lRIcrpazj/zOol/qyi0+u",
+	"7Tr21qD4TuGxKOVxilJabNrD9goyu/x/SLMAddcpAN5BlmkMzGMzCtDScto7QCvXfcWw1FeMfwrFMrkC",
+	"FEpyuZRNU5sja082Mgs2BbMCoB112V1ieeWgPVTRcfF6A0M90NH7gz7XbmO9AuKheuevlB69s91sTbHg",
+	"lRWjmhHjQi9nI5tBd+LGlW3AeFXERAcboTSu9OVszbScmbpavc2W1MOhJWLsihdcWXSEdyo353Gz2kfM",
+	"oSLAShuyhBj5RHwDyvTQsMRdqU7XoELKjawSW56Jt90Y6ULP9lSuRlFFYkdH3TF7RzYsjoj7mgUGUnK7",
+	"03HAdcsAJ+BXY0bSlhOqQliIx+ybyuglLxth3tqke78CrAZliIVaQ7cF1pMqr7SAjbk3TzgscEVLVCff",
+	"GOnXWdUU2b2HazHNRD7flYBjjdEFr4/y1CgMnSDk2o8SKEqr05hXVz1lC44VbK597OqPqq68LB5W5hlo",
+	"zSY2mQeu8aGXndsVdHhbzWKMOjy9qMMTrAjNgN9AQ3yydOEFzXRDSeFisIYux1rQz6EWdCyxHEssxxLL",
+	"scRyLLEcSyzHEsuxxHIssdxRYmkV5PGy/32XS27gtW2w39GFHqUSZk2G7RS4AvWqRMH06wfUZnghfoZ1",
+	"/eTD3f8PAAdj8+5qzQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
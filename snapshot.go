@@ -0,0 +1,163 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/itemhistory"
+)
+
+// snapshotQuery returns an unordered query of the revision of each item that
+// was in effect at the given time. Purged items are always excluded, and items
+// trashed at that time are read according to the `trashed` mode.
+func (s Server) snapshotQuery(
+	asOf time.Time, trashed *Trashed,
+) *ent.ItemHistoryQuery {
+	query := s.EC.ItemHistory.Query().Where(
+		func(stmt *sql.Selector) {
+			h := sql.Table(itemhistory.Table).As("h")
+			latest := sql.Select(sql.Max(h.C(itemhistory.FieldRevision))).
+				From(h).
+				Where(
+					sql.And(
+						sql.ColumnsEQ(
+							h.C(itemhistory.FieldItemID),
+							stmt.C(itemhistory.FieldItemID),
+						),
+						sql.LTE(h.C(itemhistory.FieldCreatedAt), asOf),
+					),
+				)
+			stmt.Where(sql.EQ(stmt.C(itemhistory.FieldRevision), latest))
+		},
+		itemhistory.ActionNEQ(itemhistory.ActionPurge),
+	)
+	switch {
+	case onlyTrashed(trashed):
+		query.Where(itemhistory.ActionEQ(itemhistory.ActionDelete))
+	case !readsTrashed(trashed, true):
+		query.Where(itemhistory.ActionNEQ(itemhistory.ActionDelete))
+	}
+	return query
+}
+
+// applySnapshotNameFilter is the snapshot counterpart of applyNameFilter.
+func applySnapshotNameFilter(name *string, query *ent.ItemHistoryQuery) {
+	if name != nil && utf8.RuneCountInString(*name) > 1 {
+		query.Where(itemhistory.NameAfterHasPrefix(*name))
+	}
+}
+
+// newItemFromHistory returns the item as it was after the given change.
+// The `updated_at` of the item is the time of the change, and `created_at` is
+// omitted.
+func newItemFromHistory(h *ent.ItemHistory) *ent.Item {
+	at := h.CreatedAt
+	row := &ent.Item{ID: h.ItemID, ParentID: h.ParentAfter, UpdatedAt: &at}
+	if nil != h.NameAfter {
+		row.Name = *h.NameAfter
+	}
+	if itemhistory.ActionDelete == h.Action {
+		row.DeletedAt = &at
+	}
+	return row
+}
+
+func newItemsFromHistory(rows []*ent.ItemHistory) []*ent.Item {
+	items := make([]*ent.Item, len(rows))
+	for i, row := range rows {
+		items[i] = newItemFromHistory(row)
+	}
+	return items
+}
+
+func mapSnapshotPage(
+	page *paginate.PaginatedList[ent.ItemHistory],
+) *paginate.PaginatedList[ent.Item] {
+	return &paginate.PaginatedList[ent.Item]{
+		Total:        page.Total,
+		PerPage:      page.PerPage,
+		CurrentPage:  page.CurrentPage,
+		LastPage:     page.LastPage,
+		FirstPageUrl: page.FirstPageUrl,
+		LastPageUrl:  page.LastPageUrl,
+		NextPageUrl:  page.NextPageUrl,
+		PrevPageUrl:  page.PrevPageUrl,
+		Path:         page.Path,
+		From:         page.From,
+		To:           page.To,
+		Data:         newItemsFromHistory(page.Data),
+	}
+}
+
+func mapSnapshotCursorPage(
+	page *cursorPage[ent.ItemHistory],
+) *cursorPage[ent.Item] {
+	return &cursorPage[ent.Item]{
+		PaginatedList: mapSnapshotPage(page.PaginatedList),
+		NextCursor:    page.NextCursor,
+		PrevCursor:    page.PrevCursor,
+	}
+}
+
+// snapshotDescendants returns all descendants of the given item at the given
+// time. Like getDescendants, items are read according to the `trashed` mode,
+// while descendants of trashed items are walked through regardless.
+func (s Server) snapshotDescendants(
+	qc context.Context, asOf time.Time, name *string, trashed *Trashed,
+	id uint32,
+) ([]*ent.Item, error) {
+	with := With
+	rows, err := s.snapshotQuery(asOf, &with).
+		Order(itemhistory.ByItemID()).All(qc)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uint32][]*ent.ItemHistory, len(rows))
+	for _, row := range rows {
+		if nil != row.ParentAfter {
+			pid := *row.ParentAfter
+			children[pid] = append(children[pid], row)
+		}
+	}
+	var items []*ent.Item
+	matches := func(h *ent.ItemHistory) bool {
+		if !readsTrashed(trashed, itemhistory.ActionDelete == h.Action) {
+			return false
+		}
+		if name == nil || utf8.RuneCountInString(*name) < 2 {
+			return true
+		}
+		return nil != h.NameAfter && strings.HasPrefix(*h.NameAfter, *name)
+	}
+	visited := map[uint32]bool{id: true}
+	queue := []uint32{id}
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		for _, row := range children[pid] {
+			if visited[row.ItemID] {
+				continue
+			}
+			visited[row.ItemID] = true
+			queue = append(queue, row.ItemID)
+			if matches(row) {
+				items = append(items, newItemFromHistory(row))
+			}
+		}
+	}
+	if nil == items {
+		return []*ent.Item{}, nil
+	}
+	slices.SortFunc(
+		items, func(a, b *ent.Item) int { return cmp.Compare(a.ID, b.ID) },
+	)
+	return items, nil
+}
@@ -4,6 +4,8 @@
 package main
 
 import (
+	"encoding/json"
+	"slices"
 	"strings"
 
 	"entgo.io/contrib/entoas"
@@ -16,12 +18,6 @@ import (
 	"github.com/ogen-go/ogen"
 )
 
-// BaseUri is the base URI for the OpenAPI endpoints.
-const BaseUri = "/simple-tree"
-
-// TableName is the name of the table in the database.
-const TableName = "items"
-
 func main() {
 	err := generate()
 	if err != nil {
@@ -47,10 +43,10 @@ func newOasExtension() (*entoas.Extension, error) {
 		entoas.Mutations(
 			func(g *gen.Graph, s *ogen.Spec) error {
 				// Comment out these when running `go generate` for the first time
-				changeBaseUri(s)
+				rootPaths(s)
 				genSpec(s)
 				constraintRequestBody(s.Paths)
-				ep := s.Paths[BaseUri]
+				ep := s.Paths["/"]
 				op := ep.Get
 				op.AddParameters(nameParam())
 				simpletree.RemoveEdges(ep.Post)
@@ -58,16 +54,17 @@ func newOasExtension() (*entoas.Extension, error) {
 					op, "Paginated list of items",
 					"#/components/schemas/ItemList",
 				)
-				ep = s.Paths[BaseUri+"/{id}"]
+				cursorPagination(s, op)
+				ep = s.Paths["/{id}"]
 				simpletree.RemoveEdges(ep.Patch)
 				err := softdelete.AttachTo(
-					"item", s, BaseUri, s.Components.Schemas["ItemRead"],
+					"item", s, "/", s.Components.Schemas["ItemRead"],
 					ep.Get.Parameters[0],
 				)
 				if err != nil {
 					return err
 				}
-				op = s.Paths[BaseUri+"/{id}/children"].Get
+				op = s.Paths["/{id}/children"].Get
 				op.AddParameters(nameParam())
 				op.SetSummary("List of subordinate items")
 				simpletree.AttachTo(op)
@@ -76,6 +73,28 @@ func newOasExtension() (*entoas.Extension, error) {
 					"Paginated list of subordinate items. Pagination is disabled when `recurse` is true.",
 					"#/components/schemas/ItemList",
 				)
+				cursorPagination(s, op)
+				siblingsEndpoint(s)
+				historyEndpoint(s)
+				revertEndpoint(s)
+				copyEndpoint(s)
+				eventsEndpoint(s)
+				webhookEndpoints(s)
+				// the outbox is internal to the service
+				delete(s.Components.Schemas, "OutboxEvent")
+				grantEndpoints(s)
+				relationEndpoint(s)
+				securitySchemes(s)
+				limitResponses(s)
+				invalidResponses(s)
+				problemResponses(s)
+				for _, p := range []string{
+					"/", "/{id}", "/{id}/children",
+				} {
+					s.Paths[p].Get.AddParameters(asOfParam())
+				}
+				itemViews(s)
+				trashedParams(s)
 				return nil
 			},
 		),
@@ -93,10 +112,16 @@ func genConfig() *gen.Config {
 	}
 }
 
-func changeBaseUri(spec *ogen.Spec) {
+// rootPaths moves item endpoints to the root of the spec. Paths of the spec
+// are relative to the `BASE_URI` setting of the service, which decides where
+// the endpoints are actually served.
+func rootPaths(spec *ogen.Spec) {
 	paths := make(ogen.Paths, len(spec.Paths))
 	for key, path := range spec.Paths {
-		nk := strings.Replace(key, "/items", BaseUri, 1)
+		nk := strings.TrimPrefix(key, "/items")
+		if "" == nk {
+			nk = "/"
+		}
 		paths[nk] = path
 	}
 	spec.SetPaths(paths)
@@ -124,6 +149,883 @@ func constraintRequestBody(paths ogen.Paths) {
 	}
 }
 
+func siblingsEndpoint(s *ogen.Spec) {
+	op := &ogen.Operation{
+		Tags:    []string{"Item"},
+		Summary: "List of sibling items",
+		Description: "Lists items having the same parent as the Item, or " +
+			"roots if the Item has no parent, including the Item itself " +
+			"unless `exclude_self` is true.",
+		OperationID: "listItemSiblings",
+		Parameters: []*ogen.Parameter{
+			idParam(), pageParam(), perPageParam(), nameParam(),
+			{
+				Name:        "exclude_self",
+				In:          "query",
+				Description: "Whether to leave the Item out of its siblings",
+				Required:    false,
+				Schema:      &ogen.Schema{Type: "boolean"},
+			},
+		},
+		Responses: ogen.Responses{
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	paginate.AttachTo(
+		op, "Paginated list of sibling items", "#/components/schemas/ItemList",
+	)
+	cursorPagination(s, op)
+	s.Paths["/{id}/siblings"] = &ogen.PathItem{Get: op}
+}
+
+func historyEndpoint(s *ogen.Spec) {
+	op := &ogen.Operation{
+		Tags:        []string{"Item"},
+		Summary:     "List change history of an Item",
+		Description: "Lists changes made to the Item, oldest first.",
+		OperationID: "listItemHistory",
+		Parameters: []*ogen.Parameter{
+			idParam(), pageParam(), perPageParam(),
+		},
+		Responses: ogen.Responses{
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	paginate.AttachTo(
+		op, "Paginated list of changes",
+		"#/components/schemas/ItemHistory",
+	)
+	s.Paths["/{id}/history"] = &ogen.PathItem{Get: op}
+}
+
+func revertEndpoint(s *ogen.Spec) {
+	b := false
+	op := &ogen.Operation{
+		Tags:    []string{"Item"},
+		Summary: "Reverts a Item to a previous revision",
+		Description: "Rolls the name and parent of the Item back to those " +
+			"after the given revision. The revert is recorded as a new revision.",
+		OperationID: "revertItem",
+		Parameters:  []*ogen.Parameter{idParam()},
+		RequestBody: &ogen.RequestBody{
+			Description: "Revision to revert to",
+			Required:    true,
+			Content: map[string]ogen.Media{
+				"application/json": {
+					Schema: &ogen.Schema{
+						Type:                 "object",
+						Required:             []string{"revision"},
+						AdditionalProperties: &ogen.AdditionalProperties{Bool: &b},
+						Properties: ogen.Properties{
+							{
+								Name: "revision",
+								Schema: &ogen.Schema{
+									Description: "Revision number to revert to",
+									Type:        "integer",
+									Format:      "uint32",
+									Minimum:     ogen.Num("1"),
+									Maximum:     ogen.Num("4294967295"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Responses: ogen.Responses{
+			"200": {
+				Description: "Item reverted",
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Ref: "#/components/schemas/ItemUpdate",
+						},
+					},
+				},
+			},
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	s.Paths["/{id}/revert"] = &ogen.PathItem{Post: op}
+}
+
+func copyEndpoint(s *ogen.Spec) {
+	b := false
+	u255 := uint64(255)
+	s.Components.Schemas["ItemCopy"] = &ogen.Schema{
+		Type:        "object",
+		Description: "Result of copying an Item with its descendants",
+		Required:    []string{"id", "ids"},
+		Properties: ogen.Properties{
+			{
+				Name: "id",
+				Schema: &ogen.Schema{
+					Type: "integer", Format: "uint32",
+					Description: "ID of the copy of the Item",
+				},
+			},
+			{
+				Name: "ids",
+				Schema: &ogen.Schema{
+					Type:        "object",
+					Description: "IDs of copies, keyed by IDs of the Items copied",
+					AdditionalProperties: &ogen.AdditionalProperties{
+						Schema: ogen.Schema{Type: "integer", Format: "uint32"},
+					},
+				},
+			},
+		},
+	}
+	op := &ogen.Operation{
+		Tags:    []string{"Item"},
+		Summary: "Copies a Item with its descendants",
+		Description: "Copies the Item and its descendants, except trashed " +
+			"ones, under the given parent in one transaction.",
+		OperationID: "copyItem",
+		Parameters:  []*ogen.Parameter{idParam()},
+		RequestBody: &ogen.RequestBody{
+			Description: "Where and how to copy the Item",
+			Required:    true,
+			Content: map[string]ogen.Media{
+				"application/json": {
+					Schema: &ogen.Schema{
+						Type:                 "object",
+						AdditionalProperties: &ogen.AdditionalProperties{Bool: &b},
+						Properties: ogen.Properties{
+							{
+								Name: "parent_id",
+								Schema: &ogen.Schema{
+									Description: "Parent of the copy, which " +
+										"is a root if omitted",
+									Type:    "integer",
+									Format:  "uint32",
+									Minimum: ogen.Num("1"),
+									Maximum: ogen.Num("4294967295"),
+								},
+							},
+							{
+								Name: "suffix",
+								Schema: &ogen.Schema{
+									Description: "Appended to the name of " +
+										"the copy of the Item, but not its " +
+										"descendants",
+									Type:      "string",
+									MaxLength: &u255,
+								},
+							},
+							{
+								Name: "depth",
+								Schema: &ogen.Schema{
+									Description: "Levels of descendants to " +
+										"copy, 0 copies the Item only. All " +
+										"descendants are copied if omitted.",
+									Type:    "integer",
+									Minimum: ogen.Num("0"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Responses: ogen.Responses{
+			"201": {
+				Description: "Item copied",
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Ref: "#/components/schemas/ItemCopy",
+						},
+					},
+				},
+			},
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	s.Paths["/{id}/copy"] = &ogen.PathItem{Post: op}
+}
+
+func relationEndpoint(s *ogen.Spec) {
+	list := &ogen.Schema{Ref: "#/components/schemas/ItemList"}
+	s.Components.Schemas["ItemRelation"] = &ogen.Schema{
+		Type:        "object",
+		Description: "How two Items relate in the tree",
+		Required: []string{
+			"id", "other", "related", "ancestor", "descendant", "path",
+		},
+		Properties: ogen.Properties{
+			{
+				Name: "id",
+				Schema: &ogen.Schema{
+					Type: "integer", Format: "uint32",
+					Description: "ID of the Item",
+				},
+			},
+			{
+				Name: "other",
+				Schema: &ogen.Schema{
+					Type: "integer", Format: "uint32",
+					Description: "ID of the other Item",
+				},
+			},
+			{
+				Name: "related",
+				Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: "Whether both Items are in the same tree",
+				},
+			},
+			{
+				Name: "ancestor",
+				Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: "Whether the Item is an ancestor of the other",
+				},
+			},
+			{
+				Name: "descendant",
+				Schema: &ogen.Schema{
+					Type: "boolean",
+					Description: "Whether the Item is a descendant of the " +
+						"other",
+				},
+			},
+			{
+				Name: "lca",
+				Schema: &ogen.Schema{
+					Description: "Lowest common ancestor, absent if the " +
+						"Items are unrelated, or it is not readable",
+					AllOf: []*ogen.Schema{list},
+				},
+			},
+			{
+				Name: "distance",
+				Schema: &ogen.Schema{
+					Type: "integer",
+					Description: "Number of edges on the path between the " +
+						"Items, absent if they are unrelated",
+				},
+			},
+			{
+				Name: "path",
+				Schema: &ogen.Schema{
+					Type: "array",
+					Description: "Readable Items on the path from the Item " +
+						"up to the lowest common ancestor and down to the " +
+						"other, both inclusive. Empty if unrelated.",
+					Items: &ogen.Items{Item: list},
+				},
+			},
+		},
+	}
+	other := idParam()
+	other.Name = "other"
+	other.Description = "ID of the other Item"
+	op := &ogen.Operation{
+		Tags:    []string{"Item"},
+		Summary: "Find how two Items relate",
+		Description: "Tells whether one Item is an ancestor of the other, " +
+			"their lowest common ancestor, and the path between them.",
+		OperationID: "readItemRelation",
+		Parameters:  []*ogen.Parameter{idParam(), other},
+		Responses: ogen.Responses{
+			"200": {
+				Description: "Relation of the Items",
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Ref: "#/components/schemas/ItemRelation",
+						},
+					},
+				},
+			},
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	s.Paths["/{id}/relation/{other}"] = &ogen.PathItem{Get: op}
+}
+
+func eventsEndpoint(s *ogen.Spec) {
+	op := &ogen.Operation{
+		Tags:    []string{"Item"},
+		Summary: "Stream Item change events",
+		Description: "Streams `create`, `update`, `move`, `delete` and " +
+			"`restore` events of Items as Server-Sent Events. Each event " +
+			"carries an ID, which can be sent back in the `Last-Event-ID` " +
+			"header to resume the stream after reconnecting.",
+		OperationID: "streamItemEvents",
+		Parameters: []*ogen.Parameter{
+			{
+				Name:        "Last-Event-ID",
+				In:          "header",
+				Description: "ID of the last event received",
+				Required:    false,
+				Schema:      &ogen.Schema{Type: "string", Pattern: "^[0-9]+$"},
+			},
+		},
+		Responses: ogen.Responses{
+			"200": {
+				Description: "Stream of Item change events",
+				Content: map[string]ogen.Media{
+					"text/event-stream": {
+						Schema: &ogen.Schema{Type: "string"},
+					},
+				},
+			},
+			"400": {Ref: "#/components/responses/400"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	s.Paths["/events"] = &ogen.PathItem{Get: op}
+}
+
+func webhookEndpoints(s *ogen.Spec) {
+	// `active` defaults to true
+	body := s.Paths["/webhooks"].Post.RequestBody.
+		Content["application/json"].Schema
+	body.Required = slices.DeleteFunc(
+		body.Required, func(name string) bool { return "active" == name },
+	)
+	op := s.Paths["/webhooks"].Get
+	op.Parameters = []*ogen.Parameter{pageParam(), perPageParam()}
+	paginate.AttachTo(
+		op, "Paginated list of webhooks",
+		"#/components/schemas/WebhookList",
+	)
+	param := idParam()
+	param.Description = "ID of the Webhook"
+	op = &ogen.Operation{
+		Tags:    []string{"Webhook"},
+		Summary: "List dead letters of a Webhook",
+		Description: "Lists events that couldn't be delivered to the " +
+			"Webhook after all attempts, oldest first.",
+		OperationID: "listWebhookDeadLetter",
+		Parameters: []*ogen.Parameter{
+			param, pageParam(), perPageParam(),
+		},
+		Responses: ogen.Responses{
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	paginate.AttachTo(
+		op, "Paginated list of dead letters",
+		"#/components/schemas/WebhookDelivery",
+	)
+	s.Paths["/webhooks/{id}/dead-letters"] = &ogen.PathItem{Get: op}
+}
+
+func grantEndpoints(s *ogen.Spec) {
+	// shares the permission enum between grants and the request body
+	permission := &ogen.Schema{Ref: "#/components/schemas/Permission"}
+	props := s.Components.Schemas["Grant"].Properties
+	for i := range props {
+		if "permission" == props[i].Name {
+			s.Components.Schemas["Permission"] = props[i].Schema
+			props[i].Schema.Description = "Permission granted, `write` " +
+				"includes `read`, and `admin` includes both"
+			props[i].Schema = permission
+		}
+	}
+	u1 := uint64(1)
+	u255 := uint64(255)
+	subject := &ogen.Parameter{
+		Name:        "subject",
+		In:          "path",
+		Description: "Subject granted, as authenticated",
+		Required:    true,
+		Schema: &ogen.Schema{
+			Type: "string", MinLength: &u1, MaxLength: &u255,
+		},
+	}
+	grant := map[string]ogen.Media{
+		"application/json": {
+			Schema: &ogen.Schema{Ref: "#/components/schemas/Grant"},
+		},
+	}
+	list := &ogen.Operation{
+		Tags:    []string{"Grant"},
+		Summary: "List grants of an Item",
+		Description: "Lists grants attached to the Item itself, excluding " +
+			"those inherited from its ancestors.",
+		OperationID: "listItemGrant",
+		Parameters:  []*ogen.Parameter{idParam()},
+		Responses: ogen.Responses{
+			"200": {
+				Description: "Grants of the Item",
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "array",
+							Items: &ogen.Items{
+								Item: &ogen.Schema{
+									Ref: "#/components/schemas/Grant",
+								},
+							},
+						},
+					},
+				},
+			},
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	b := false
+	set := &ogen.Operation{
+		Tags:    []string{"Grant"},
+		Summary: "Grants a subject permission on an Item",
+		Description: "Creates or replaces the grant of the subject on the " +
+			"Item, which is inherited by all descendants of the Item.",
+		OperationID: "setItemGrant",
+		Parameters:  []*ogen.Parameter{idParam(), subject},
+		RequestBody: &ogen.RequestBody{
+			Description: "Permission to grant",
+			Required:    true,
+			Content: map[string]ogen.Media{
+				"application/json": {
+					Schema: &ogen.Schema{
+						Type:                 "object",
+						Required:             []string{"permission"},
+						AdditionalProperties: &ogen.AdditionalProperties{Bool: &b},
+						Properties: ogen.Properties{
+							{Name: "permission", Schema: permission},
+						},
+					},
+				},
+			},
+		},
+		Responses: ogen.Responses{
+			"200": {Description: "Grant of the subject", Content: grant},
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	del := &ogen.Operation{
+		Tags:        []string{"Grant"},
+		Summary:     "Revokes the grant of a subject on an Item",
+		OperationID: "deleteItemGrant",
+		Parameters:  []*ogen.Parameter{idParam(), subject},
+		Responses: ogen.Responses{
+			"204": {Description: "Grant revoked"},
+			"400": {Ref: "#/components/responses/400"},
+			"404": {Ref: "#/components/responses/404"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	s.Paths["/{id}/grants"] = &ogen.PathItem{Get: list}
+	s.Paths["/{id}/grants/{subject}"] = &ogen.PathItem{
+		Put: set, Delete: del,
+	}
+}
+
+func securitySchemes(s *ogen.Spec) {
+	s.Components.SecuritySchemes = map[string]*ogen.SecurityScheme{
+		"bearerAuth": {
+			Type:         "http",
+			Description:  "JWT signed with HS256 or RS256",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+		},
+		"apiKeyAuth": {
+			Type:        "apiKey",
+			Description: "Static API key",
+			Name:        "X-API-Key",
+			In:          "header",
+		},
+	}
+	// either one of the schemes is accepted
+	s.Security = ogen.SecurityRequirements{
+		{"bearerAuth": []string{}}, {"apiKeyAuth": []string{}},
+	}
+	res := *s.Components.Responses["404"]
+	res.Description = "missing or invalid credentials"
+	s.Components.Responses["401"] = &res
+	for _, path := range s.Paths {
+		for _, op := range []*ogen.Operation{
+			path.Get, path.Put, path.Post, path.Delete, path.Patch,
+		} {
+			if nil != op {
+				op.Responses["401"] = &ogen.Response{
+					Ref: "#/components/responses/401",
+				}
+				op.Responses["403"] = &ogen.Response{
+					Ref: "#/components/responses/403",
+				}
+			}
+		}
+	}
+}
+
+// problemResponses declares the RFC 7807 alternative of error responses,
+// answered if configured or requested by the `Accept` header.
+func problemResponses(s *ogen.Spec) {
+	str := func(desc string) *ogen.Schema {
+		return &ogen.Schema{Type: "string", Description: desc}
+	}
+	s.Components.Schemas["Problem"] = &ogen.Schema{
+		Type:        "object",
+		Description: "Problem details of an error, as defined by RFC 7807",
+		Required:    []string{"type", "title", "status"},
+		Properties: ogen.Properties{
+			{
+				Name:   "type",
+				Schema: str("URI of the problem type, documented in README"),
+			},
+			{Name: "title", Schema: str("Summary of the problem type")},
+			{
+				Name: "status",
+				Schema: &ogen.Schema{
+					Type: "integer", Description: "HTTP status code",
+				},
+			},
+			{Name: "detail", Schema: str("Explanation of the occurrence")},
+			{Name: "instance", Schema: str("Path of the request")},
+			{
+				Name: "invalid-params",
+				Schema: &ogen.Schema{
+					Type:        "array",
+					Description: "Parameters or properties failing validation",
+					Items: &ogen.Items{
+						Item: &ogen.Schema{
+							Type:     "object",
+							Required: []string{"reason"},
+							Properties: ogen.Properties{
+								{
+									Name: "name",
+									Schema: str(
+										"Parameter name, or dot separated " +
+											"path of the property",
+									),
+								},
+								{
+									Name: "in",
+									Schema: str(
+										"path, query, header or body",
+									),
+								},
+								{Name: "reason", Schema: str("What is wrong")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	problem := ogen.Media{
+		Schema: &ogen.Schema{Ref: "#/components/schemas/Problem"},
+	}
+	for _, res := range s.Components.Responses {
+		if _, ok := res.Content["application/json"]; ok {
+			res.Content["application/problem+json"] = problem
+		}
+	}
+}
+
+func limitResponses(s *ogen.Spec) {
+	tooLarge := *s.Components.Responses["404"]
+	tooLarge.Description = "request body too large"
+	s.Components.Responses["413"] = &tooLarge
+	res := *s.Components.Responses["404"]
+	res.Description = "rate limit exceeded"
+	res.Headers = map[string]*ogen.Header{
+		"Retry-After": {
+			Description: "Seconds to wait before retrying",
+			Schema:      &ogen.Schema{Type: "integer", Minimum: ogen.Num("1")},
+		},
+	}
+	s.Components.Responses["429"] = &res
+	for _, path := range s.Paths {
+		for _, op := range []*ogen.Operation{
+			path.Get, path.Put, path.Post, path.Delete, path.Patch,
+		} {
+			if nil == op {
+				continue
+			}
+			op.Responses["429"] = &ogen.Response{
+				Ref: "#/components/responses/429",
+			}
+			if nil != op.RequestBody {
+				op.Responses["413"] = &ogen.Response{
+					Ref: "#/components/responses/413",
+				}
+			}
+		}
+	}
+}
+
+// cursorPagination declares the cursor pagination mode of the list operation,
+// selected by the `pagination` or `cursor` parameter. Items are neither
+// counted nor skipped in this mode, so counts and page numbers of the page
+// are 0. The cursor schema is named `Cursor`, which also has the pagination
+// modes of all list operations prefixed by their types in generated code.
+func cursorPagination(s *ogen.Spec, op *ogen.Operation) {
+	u1 := uint64(1)
+	u255 := uint64(255)
+	s.Components.Schemas["Cursor"] = &ogen.Schema{
+		Type:        "string",
+		Description: "Opaque position of a page in cursor pagination",
+		MinLength:   &u1,
+		MaxLength:   &u255,
+	}
+	op.AddParameters(
+		&ogen.Parameter{
+			Name: "pagination",
+			In:   "query",
+			Description: "Pagination mode, defaults to `offset`. `cursor` " +
+				"pagination neither counts nor skips items, and ignores `page`",
+			Required: false,
+			Schema: &ogen.Schema{
+				Type: "string",
+				Enum: []json.RawMessage{[]byte(`"offset"`), []byte(`"cursor"`)},
+			},
+		},
+		&ogen.Parameter{
+			Name: "cursor",
+			In:   "query",
+			Description: "Opaque cursor from `next_cursor` or `prev_cursor` " +
+				"of a previous page, implying cursor pagination",
+			Required: false,
+			Schema:   &ogen.Schema{Ref: "#/components/schemas/Cursor"},
+		},
+	)
+	page := op.Responses["200"].Content["application/json"].Schema
+	for _, prop := range page.Properties {
+		switch prop.Name {
+		case "current_page", "total", "last_page", "from", "to":
+			prop.Schema.Minimum = ogen.Num("0")
+			prop.Schema.Description += ", 0 in cursor pagination"
+		case "last_page_url":
+			prop.Schema.Description += ", empty in cursor pagination"
+		}
+	}
+	page.Properties = append(
+		page.Properties,
+		ogen.Property{
+			Name: "next_cursor",
+			Schema: &ogen.Schema{
+				Type: "string",
+				Description: "Cursor of the next page in cursor " +
+					"pagination, absent on the last page",
+			},
+		},
+		ogen.Property{
+			Name: "prev_cursor",
+			Schema: &ogen.Schema{
+				Type: "string",
+				Description: "Cursor of the previous page in cursor " +
+					"pagination, absent on the first page",
+			},
+		},
+	)
+}
+
+// itemViews declares sparse fieldsets and edge expansion of item reads.
+// Expanded items are always listed in the `ItemList` schema.
+func itemViews(s *ogen.Spec) {
+	list := s.Components.Schemas["ItemList"]
+	softdelete.AddDeletedAtField(list)
+	ref := &ogen.Schema{Ref: "#/components/schemas/ItemList"}
+	edges := ogen.Properties{
+		{
+			Name: "parent",
+			Schema: &ogen.Schema{
+				Description: "Parent of the item, if expanded and readable",
+				AllOf:       []*ogen.Schema{ref},
+			},
+		},
+		{
+			Name: "children",
+			Schema: &ogen.Schema{
+				Type:        "array",
+				Description: "Children of the item, if expanded",
+				Items:       &ogen.Items{Item: ref},
+			},
+		},
+		{
+			Name: "ancestors",
+			Schema: &ogen.Schema{
+				Type: "array",
+				Description: "Readable ancestors of the item, root first, " +
+					"if expanded",
+				Items: &ogen.Items{Item: ref},
+			},
+		},
+	}
+	list.Properties = append(list.Properties, edges...)
+	read := s.Components.Schemas["ItemRead"]
+	read.Properties = append(read.Properties, edges...)
+	s.Components.Schemas["ItemField"] = enumSchema(
+		"Field of items", "id", "name", "parent_id", "created_at",
+		"updated_at", "deleted_at",
+	)
+	s.Components.Schemas["ItemEdge"] = enumSchema(
+		"Edge of items", "parent", "children", "ancestors",
+	)
+	for _, p := range []string{
+		"/", "/{id}", "/{id}/children", "/{id}/siblings",
+	} {
+		s.Paths[p].Get.AddParameters(
+			listParam(
+				"fields", "ItemField",
+				"Comma separated fields to include, `id` and `name` are "+
+					"always included. All fields are included if omitted.",
+			),
+			listParam(
+				"expand", "ItemEdge",
+				"Comma separated edges to embed in items. Not supported "+
+					"with `as_of`.",
+			),
+		)
+	}
+}
+
+// trashedParams replaces the boolean `trashed` parameter of item reads by
+// the `Trashed` mode, shared by all reads. Boolean values of the former
+// parameter are kept as deprecated aliases. The parameter of deletion is
+// kept, which purges trashed items.
+func trashedParams(s *ogen.Spec) {
+	s.Components.Schemas["Trashed"] = enumSchema(
+		"Whether trashed items are read. `1` and `true` are deprecated "+
+			"aliases of `with`, `0` and `false` of `without`.",
+		"without", "with", "only", "1", "true", "0", "false",
+	)
+	softdelete.AddDeletedAtField(s.Components.Schemas["Item_ParentRead"])
+	for _, p := range []string{
+		"/", "/{id}", "/{id}/children", "/{id}/parent", "/{id}/siblings",
+	} {
+		op := s.Paths[p].Get
+		op.Parameters = slices.DeleteFunc(
+			op.Parameters, func(param *ogen.Parameter) bool {
+				return softdelete.ParamTrashed == param.Name
+			},
+		)
+		op.AddParameters(
+			&ogen.Parameter{
+				Name: softdelete.ParamTrashed,
+				In:   "query",
+				Description: "Read items `without` trashed ones (default), " +
+					"`with` trashed ones, or trashed ones `only`",
+				Required: false,
+				Schema:   &ogen.Schema{Ref: "#/components/schemas/Trashed"},
+			},
+		)
+	}
+}
+
+func enumSchema(desc string, values ...string) *ogen.Schema {
+	enum := make([]json.RawMessage, len(values))
+	for i, v := range values {
+		enum[i], _ = json.Marshal(v)
+	}
+	return &ogen.Schema{Type: "string", Description: desc, Enum: enum}
+}
+
+// listParam returns a query parameter of comma separated values of the
+// schema.
+func listParam(name, schema, desc string) *ogen.Parameter {
+	b := false
+	return &ogen.Parameter{
+		Name:        name,
+		In:          "query",
+		Description: desc,
+		Required:    false,
+		Style:       "form",
+		Explode:     &b,
+		Schema: &ogen.Schema{
+			Type: "array",
+			Items: &ogen.Items{
+				Item: &ogen.Schema{Ref: "#/components/schemas/" + schema},
+			},
+			UniqueItems: true,
+		},
+	}
+}
+
+// invalidResponses declares the 422 response of operations validating the
+// request body against the database, e.g. the parent of an item must exist.
+func invalidResponses(s *ogen.Spec) {
+	res := *s.Components.Responses["404"]
+	res.Description = "request refers to data that doesn't exist"
+	s.Components.Responses["422"] = &res
+	for _, path := range s.Paths {
+		for _, op := range []*ogen.Operation{path.Put, path.Post, path.Patch} {
+			if nil != op && nil != op.RequestBody {
+				op.Responses["422"] = &ogen.Response{
+					Ref: "#/components/responses/422",
+				}
+			}
+		}
+	}
+}
+
+func idParam() *ogen.Parameter {
+	return &ogen.Parameter{
+		Name:        "id",
+		In:          "path",
+		Description: "ID of the Item",
+		Required:    true,
+		Schema: &ogen.Schema{
+			Type:    "integer",
+			Format:  "uint32",
+			Minimum: ogen.Num("1"),
+			Maximum: ogen.Num("4294967295"),
+		},
+	}
+}
+
+func pageParam() *ogen.Parameter {
+	return &ogen.Parameter{
+		Name:        "page",
+		In:          "query",
+		Description: "what page to render",
+		Required:    false,
+		Schema:      &ogen.Schema{Type: "integer", Minimum: ogen.Num("1")},
+	}
+}
+
+func perPageParam() *ogen.Parameter {
+	return &ogen.Parameter{
+		Name:        "per_page",
+		In:          "query",
+		Description: "item count to render per page",
+		Required:    false,
+		Schema: &ogen.Schema{
+			Type:    "integer",
+			Minimum: ogen.Num("1"),
+			Maximum: ogen.Num("255"),
+		},
+	}
+}
+
+func asOfParam() *ogen.Parameter {
+	return &ogen.Parameter{
+		Name:        "as_of",
+		In:          "query",
+		Description: "Read items as they were at the given time, reconstructed from item history",
+		Required:    false,
+		Schema:      &ogen.Schema{Type: "string", Format: "date-time"},
+	}
+}
+
 func nameParam() *ogen.Parameter {
 	u2 := uint64(2)
 	u255 := uint64(255)
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the instrumentation scope of all spans.
+const tracerName = "github.com/eidng8/go-simple-tree"
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// setupTracing installs the global tracer provider exporting spans as
+// configured. Tracing is disabled unless an exporter is set. The returned
+// function flushes and stops the exporter.
+func setupTracing(
+	ctx context.Context, cfg TracingConfig,
+) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var closer io.Closer
+	switch name := cfg.Exporter; name {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		// endpoint & headers are read from `OTEL_EXPORTER_OTLP_*` variables
+		exp, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("OTEL_TRACES_EXPORTER: %w", err)
+		}
+		exporter = exp
+	case "console":
+		var w io.Writer = os.Stdout
+		if path := cfg.File; "" != path {
+			file, err := os.OpenFile(
+				path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("TRACES_FILE: %w", err)
+			}
+			w, closer = file, file
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			return nil, err
+		}
+		exporter = exp
+	default:
+		return nil, fmt.Errorf("OTEL_TRACES_EXPORTER: unknown exporter %q", name)
+	}
+	// `OTEL_SERVICE_NAME` & `OTEL_RESOURCE_ATTRIBUTES` take precedence
+	res, err := resource.New(
+		ctx, resource.WithAttributes(semconv.ServiceName("simple-tree")),
+		resource.WithTelemetrySDK(), resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter), sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{}, propagation.Baggage{},
+		),
+	)
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if nil != closer {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
+
+// tracingMiddleware starts a span for each request, continuing the trace
+// propagated by the client if any.
+func tracingMiddleware(gc *gin.Context) {
+	ctx := otel.GetTextMapPropagator().Extract(
+		gc.Request.Context(), propagation.HeaderCarrier(gc.Request.Header),
+	)
+	route := routePath(gc)
+	name := gc.Request.Method + " " + route
+	if "" == route {
+		name = gc.Request.Method
+	}
+	ctx, span := tracer().Start(
+		ctx, name, trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(gc.Request.Method),
+			semconv.HTTPRoute(route),
+			semconv.URLPath(gc.Request.URL.Path),
+			semconv.ClientAddress(gc.ClientIP()),
+		),
+	)
+	defer span.End()
+	gc.Request = gc.Request.WithContext(ctx)
+	gc.Next()
+	status := gc.Writer.Status()
+	span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+	if status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(status))
+	}
+}
+
+// traceOperation starts a span named after the `StrictServerInterface`
+// operation around its handler. Encoding the response is not part of the span.
+func traceOperation(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	return func(gc *gin.Context, request interface{}) (interface{}, error) {
+		parent := gc.Request
+		ctx, span := tracer().Start(parent.Context(), operationID)
+		defer span.End()
+		gc.Request = parent.WithContext(ctx)
+		defer func() { gc.Request = parent }()
+		res, err := f(gc, request)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return res, err
+	}
+}
+
+// tracingDriver starts a span for each statement executed by the driver.
+type tracingDriver struct {
+	dialect.Driver
+}
+
+func (d *tracingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.Dialect(), "exec", query, func() error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *tracingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.Dialect(), "query", query, func() error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *tracingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+// tracingTx starts a span for each statement executed in the transaction.
+type tracingTx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (t *tracingTx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.dialect, "exec", query, func() error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *tracingTx) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.dialect, "query", query, func() error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// traceStatement runs the statement in a client span. Arguments of the
+// statement are not recorded, as they may carry sensitive data. Statements
+// outside any trace, such as those polling the outbox, are not traced.
+func traceStatement(
+	ctx context.Context, system, method, query string, run func() error,
+) error {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return run()
+	}
+	_, span := tracer().Start(
+		ctx, method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemKey.String(system), semconv.DBQueryText(query),
+		),
+	)
+	defer span.End()
+	err := run()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/enttest"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// recordSpans installs a tracer provider recording all ended spans until the
+// test finishes.
+func recordSpans(tb testing.TB) *tracetest.SpanRecorder {
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	tb.Cleanup(
+		func() {
+			otel.SetTracerProvider(provider)
+			otel.SetTextMapPropagator(propagator)
+		},
+	)
+	return recorder
+}
+
+func findSpan(spans []sdktrace.ReadOnlySpan, name string) sdktrace.ReadOnlySpan {
+	for _, span := range spans {
+		if name == span.Name() {
+			return span
+		}
+	}
+	return nil
+}
+
+func Test_tracing_spans_requests_handlers_and_statements(t *testing.T) {
+	recorder := recordSpans(t)
+	db, err := sql.Open(dialect.SQLite, ":memory:?_fk=1")
+	assert.Nil(t, err)
+	db.SetMaxOpenConns(1)
+	drv := &tracingDriver{Driver: entsql.OpenDB(dialect.SQLite, db)}
+	entClient := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	defer func() { _ = entClient.Close() }()
+	_, engine, err := newEngine(entClient, testConfig(t))
+	assert.Nil(t, err)
+	fixture(entClient)
+	// nothing is traced outside requests
+	assert.Empty(t, recorder.Ended())
+	req := httptest.NewRequest(http.MethodGet, schema.BaseUri+"/1", nil)
+	traceparent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	req.Header.Set("traceparent", traceparent)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	spans := recorder.Ended()
+	server := findSpan(spans, "GET "+schema.BaseUri+"/:id")
+	assert.NotNil(t, server)
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+	assert.Equal(
+		t, "0af7651916cd43dd8448eb211c80319c",
+		server.SpanContext().TraceID().String(),
+	)
+	assert.Equal(t, "b7ad6b7169203331", server.Parent().SpanID().String())
+	op := findSpan(spans, "ReadItem")
+	assert.NotNil(t, op)
+	assert.Equal(t, server.SpanContext().SpanID(), op.Parent().SpanID())
+	query := findSpan(spans, "query")
+	assert.NotNil(t, query)
+	assert.Equal(t, op.SpanContext().SpanID(), query.Parent().SpanID())
+	assert.Equal(t, trace.SpanKindClient, query.SpanKind())
+}
+
+func Test_tracing_records_failed_statements(t *testing.T) {
+	recorder := recordSpans(t)
+	db, err := sql.Open(dialect.SQLite, ":memory:")
+	assert.Nil(t, err)
+	drv := &tracingDriver{Driver: entsql.OpenDB(dialect.SQLite, db)}
+	ctx, span := tracer().Start(context.Background(), "test")
+	tx, err := drv.Tx(ctx)
+	assert.Nil(t, err)
+	assert.NotNil(t, tx.Exec(ctx, "INSERT INTO nowhere VALUES (1)", []any{}, nil))
+	assert.Nil(t, tx.Rollback())
+	span.End()
+	exec := findSpan(recorder.Ended(), "exec")
+	assert.NotNil(t, exec)
+	assert.Equal(t, "Error", exec.Status().Code.String())
+	assert.Equal(t, span.SpanContext().SpanID(), exec.Parent().SpanID())
+}
+
+func Test_setupTracing_writes_spans_to_file(t *testing.T) {
+	provider, propagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	defer func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	}()
+	path := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := setupTracing(
+		context.Background(),
+		TracingConfig{Exporter: "console", File: path},
+	)
+	assert.Nil(t, err)
+	_, span := tracer().Start(context.Background(), "test span")
+	span.End()
+	assert.Nil(t, shutdown(context.Background()))
+	b, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), `"Name":"test span"`)
+	assert.Contains(t, string(b), `"Value":"simple-tree"`)
+}
+
+func Test_setupTracing_fails_on_unknown_exporter(t *testing.T) {
+	_, err := setupTracing(
+		context.Background(), TracingConfig{Exporter: "zipkin"},
+	)
+	assert.ErrorContains(t, err, "OTEL_TRACES_EXPORTER")
+}
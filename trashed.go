@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/eidng8/go-ent/softdelete"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/item"
+)
+
+// trashedMode returns the `trashed` mode with deprecated boolean aliases
+// resolved. Trashed items are left out by default.
+func trashedMode(mode *Trashed) Trashed {
+	if nil == mode {
+		return Without
+	}
+	switch *mode {
+	case N1, True:
+		return With
+	case N0, False:
+		return Without
+	}
+	return *mode
+}
+
+// trashedContext returns the query context of the `trashed` mode.
+func trashedContext(ctx context.Context, mode *Trashed) context.Context {
+	if Without != trashedMode(mode) {
+		return softdelete.IncludeTrashed(ctx)
+	}
+	return ctx
+}
+
+func onlyTrashed(mode *Trashed) bool {
+	return Only == trashedMode(mode)
+}
+
+// applyTrashedFilter leaves out live items from the query if only trashed
+// ones are wanted. The query must be run in the context of trashedContext.
+func applyTrashedFilter(mode *Trashed, query *ent.ItemQuery) {
+	if onlyTrashed(mode) {
+		query.Where(item.DeletedAtNotNil())
+	}
+}
+
+// readsTrashed tells whether an item, trashed or not, is read in the mode.
+func readsTrashed(mode *Trashed, trashed bool) bool {
+	switch {
+	case onlyTrashed(mode):
+		return trashed
+	case With == trashedMode(mode):
+		return true
+	}
+	return !trashed
+}
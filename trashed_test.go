@@ -0,0 +1,164 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+// trashedTree makes items 2 & 3 children of item 1, and item 4 child of item
+// 2. Items 2 & 5 are trashed.
+var trashedTree = map[uint32]uint32{2: 1, 3: 1, 4: 2}
+
+func getPageIds(t *testing.T, engine http.Handler, uri string) []uint32 {
+	res := serveRequest(engine, http.MethodGet, uri, "")
+	assert.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	var page ListItem200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &page))
+	for _, row := range page.Data {
+		trashed := 2 == row.Id || 5 == row.Id
+		assert.Equal(t, trashed, row.DeletedAt.IsSpecified(), row.Id)
+	}
+	return pageIds(page)
+}
+
+func Test_ListItem_reads_trashed_items_by_mode(t *testing.T) {
+	engine, _ := setupTree(t, trashedTree, 2, 5)
+	base := schema.BaseUri + "?per_page=5&trashed="
+	assert.Equal(
+		t, []uint32{1, 3, 4, 6, 7}, getPageIds(t, engine, base+"without"),
+	)
+	assert.Equal(t, idRange(1, 5), getPageIds(t, engine, base+"with"))
+	assert.Equal(t, []uint32{2, 5}, getPageIds(t, engine, base+"only"))
+	assert.Equal(
+		t, []uint32{2, 5},
+		getPageIds(t, engine, base+"only&pagination=cursor"),
+	)
+}
+
+func Test_ListItemChildren_reads_trashed_items_by_mode(t *testing.T) {
+	engine, _ := setupTree(t, trashedTree, 2, 5)
+	tests := []struct {
+		query string
+		ids   []uint32
+	}{
+		{"", []uint32{3}},
+		{"?trashed=without", []uint32{3}},
+		{"?trashed=with", []uint32{2, 3}},
+		{"?trashed=only", []uint32{2}},
+		{"?recurse=1", []uint32{3, 4}},
+		{"?recurse=1&trashed=with", []uint32{2, 3, 4}},
+		{"?recurse=1&trashed=only", []uint32{2}},
+	}
+	for _, test := range tests {
+		assert.Equal(
+			t, test.ids,
+			getPageIds(t, engine, schema.BaseUri+"/1/children"+test.query),
+			test.query,
+		)
+	}
+}
+
+func Test_snapshots_read_trashed_items_by_mode(t *testing.T) {
+	engine, entClient := setupTree(t, trashedTree, 2, 5)
+	backdateHistory(t, entClient, time.Hour)
+	base := schema.BaseUri + "/1/children?as_of=" + url.QueryEscape(
+		time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+	)
+	assert.Equal(t, []uint32{3}, getPageIds(t, engine, base))
+	assert.Equal(
+		t, []uint32{2, 3}, getPageIds(t, engine, base+"&trashed=with"),
+	)
+	assert.Equal(
+		t, []uint32{2}, getPageIds(t, engine, base+"&trashed=only"),
+	)
+	assert.Equal(
+		t, []uint32{2, 3, 4},
+		getPageIds(t, engine, base+"&recurse=1&trashed=with"),
+	)
+	assert.Equal(
+		t, []uint32{2},
+		getPageIds(t, engine, base+"&recurse=1&trashed=only"),
+	)
+}
+
+func Test_ReadItem_reads_trashed_item_by_mode(t *testing.T) {
+	engine, _ := setupTree(t, trashedTree, 2, 5)
+	tests := []struct {
+		uri  string
+		code int
+	}{
+		{"/2", http.StatusNotFound},
+		{"/2?trashed=with", http.StatusOK},
+		{"/2?trashed=only", http.StatusOK},
+		{"/3?trashed=only", http.StatusNotFound},
+		{"/3?trashed=with", http.StatusOK},
+	}
+	for _, test := range tests {
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+test.uri, "",
+		)
+		assert.Equal(t, test.code, res.Code, test.uri)
+	}
+}
+
+func Test_ReadItemParent_reads_trashed_parent_by_mode(t *testing.T) {
+	engine, _ := setupTree(t, trashedTree, 2, 5)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/4/parent", "")
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	assert.JSONEq(
+		t,
+		`{"code":404,"status":"Not Found","errors":"parent of item 4: not found"}`,
+		res.Body.String(),
+	)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/4/parent?trashed=only", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var parent ReadItemParent200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &parent))
+	assert.Equal(t, uint32(2), parent.Id)
+	assert.True(t, parent.DeletedAt.IsSpecified())
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2/parent?trashed=with", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &parent))
+	assert.Equal(t, uint32(1), parent.Id)
+	res = serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/3/parent?trashed=only", "",
+	)
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_boolean_trashed_modes_are_aliases(t *testing.T) {
+	engine, _ := setupTree(t, trashedTree, 2, 5)
+	base := schema.BaseUri + "?per_page=5&trashed="
+	for _, mode := range []string{"1", "true"} {
+		assert.Equal(t, idRange(1, 5), getPageIds(t, engine, base+mode), mode)
+	}
+	for _, mode := range []string{"0", "false"} {
+		assert.Equal(
+			t, []uint32{1, 3, 4, 6, 7}, getPageIds(t, engine, base+mode), mode,
+		)
+	}
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"/2?trashed=true", "",
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_trashed_mode_must_be_known(t *testing.T) {
+	engine, _ := setupTree(t, trashedTree, 2, 5)
+	for _, uri := range []string{"", "/1", "/1/children", "/2/parent"} {
+		res := serveRequest(
+			engine, http.MethodGet, schema.BaseUri+uri+"?trashed=maybe", "",
+		)
+		assert.Equal(t, http.StatusBadRequest, res.Code, uri)
+	}
+}
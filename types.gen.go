@@ -8,6 +8,100 @@ import (
 	"github.com/oapi-codegen/nullable"
 )
 
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ItemEdge.
+const (
+	Ancestors ItemEdge = "ancestors"
+	Children  ItemEdge = "children"
+	Parent    ItemEdge = "parent"
+)
+
+// Defines values for ItemField.
+const (
+	CreatedAt ItemField = "created_at"
+	DeletedAt ItemField = "deleted_at"
+	Id        ItemField = "id"
+	Name      ItemField = "name"
+	ParentId  ItemField = "parent_id"
+	UpdatedAt ItemField = "updated_at"
+)
+
+// Defines values for ItemHistoryAction.
+const (
+	Create  ItemHistoryAction = "create"
+	Delete  ItemHistoryAction = "delete"
+	Purge   ItemHistoryAction = "purge"
+	Restore ItemHistoryAction = "restore"
+	Revert  ItemHistoryAction = "revert"
+	Update  ItemHistoryAction = "update"
+)
+
+// Defines values for Permission.
+const (
+	Admin Permission = "admin"
+	Read  Permission = "read"
+	Write Permission = "write"
+)
+
+// Defines values for Trashed.
+const (
+	False   Trashed = "false"
+	N0      Trashed = "0"
+	N1      Trashed = "1"
+	Only    Trashed = "only"
+	True    Trashed = "true"
+	With    Trashed = "with"
+	Without Trashed = "without"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Dead      WebhookDeliveryStatus = "dead"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for ListItemParamsPagination.
+const (
+	ListItemParamsPaginationCursor ListItemParamsPagination = "cursor"
+	ListItemParamsPaginationOffset ListItemParamsPagination = "offset"
+)
+
+// Defines values for ListItemChildrenParamsPagination.
+const (
+	ListItemChildrenParamsPaginationCursor ListItemChildrenParamsPagination = "cursor"
+	ListItemChildrenParamsPaginationOffset ListItemChildrenParamsPagination = "offset"
+)
+
+// Defines values for ListItemSiblingsParamsPagination.
+const (
+	ListItemSiblingsParamsPaginationCursor ListItemSiblingsParamsPagination = "cursor"
+	ListItemSiblingsParamsPaginationOffset ListItemSiblingsParamsPagination = "offset"
+)
+
+// Cursor Opaque position of a page in cursor pagination
+type Cursor = string
+
+// Grant defines model for Grant.
+type Grant struct {
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+
+	// ItemId ID of the item granted
+	ItemId uint32 `json:"item_id" yaml:"item_id" xml:"item_id" bson:"item_id"`
+
+	// Permission Permission granted, `write` includes `read`, and `admin` includes both
+	Permission Permission `json:"permission" yaml:"permission" xml:"permission" bson:"permission"`
+
+	// Subject Subject granted, as authenticated
+	Subject   string     `json:"subject" yaml:"subject" xml:"subject" bson:"subject"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
+
 // Item defines model for Item.
 type Item struct {
 	Children  *[]Item    `json:"children,omitempty" yaml:"children,omitempty" xml:"children,omitempty" bson:"children,omitempty"`
@@ -23,6 +117,15 @@ type Item struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
 }
 
+// ItemCopy Result of copying an Item with its descendants
+type ItemCopy struct {
+	// Id ID of the copy of the Item
+	Id uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+
+	// Ids IDs of copies, keyed by IDs of the Items copied
+	Ids map[string]uint32 `json:"ids" yaml:"ids" xml:"ids" bson:"ids"`
+}
+
 // ItemCreate defines model for ItemCreate.
 type ItemCreate struct {
 	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
@@ -36,14 +139,57 @@ type ItemCreate struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
 }
 
+// ItemEdge Edge of items
+type ItemEdge string
+
+// ItemField Field of items
+type ItemField string
+
+// ItemHistory defines model for ItemHistory.
+type ItemHistory struct {
+	Action    ItemHistoryAction `json:"action" yaml:"action" xml:"action" bson:"action"`
+	Actor     *string           `json:"actor,omitempty" yaml:"actor,omitempty" xml:"actor,omitempty" bson:"actor,omitempty"`
+	CreatedAt time.Time         `json:"created_at" yaml:"created_at" xml:"created_at" bson:"created_at"`
+	Id        uint64            `json:"id" yaml:"id" xml:"id" bson:"id"`
+
+	// ItemId ID of the changed item
+	ItemId     uint32  `json:"item_id" yaml:"item_id" xml:"item_id" bson:"item_id"`
+	NameAfter  *string `json:"name_after,omitempty" yaml:"name_after,omitempty" xml:"name_after,omitempty" bson:"name_after,omitempty"`
+	NameBefore *string `json:"name_before,omitempty" yaml:"name_before,omitempty" xml:"name_before,omitempty" bson:"name_before,omitempty"`
+
+	// ParentAfter Parent ID after the change
+	ParentAfter *uint32 `json:"parent_after,omitempty" yaml:"parent_after,omitempty" xml:"parent_after,omitempty" bson:"parent_after,omitempty"`
+
+	// ParentBefore Parent ID before the change
+	ParentBefore *uint32 `json:"parent_before,omitempty" yaml:"parent_before,omitempty" xml:"parent_before,omitempty" bson:"parent_before,omitempty"`
+	RequestId    *string `json:"request_id,omitempty" yaml:"request_id,omitempty" xml:"request_id,omitempty" bson:"request_id,omitempty"`
+
+	// Revision Revision number of the item, starting from 1
+	Revision uint32 `json:"revision" yaml:"revision" xml:"revision" bson:"revision"`
+}
+
+// ItemHistoryAction defines model for ItemHistory.Action.
+type ItemHistoryAction string
+
 // ItemList defines model for ItemList.
 type ItemList struct {
-	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
-	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+	// Ancestors Readable ancestors of the item, root first, if expanded
+	Ancestors *[]ItemList `json:"ancestors,omitempty" yaml:"ancestors,omitempty" xml:"ancestors,omitempty" bson:"ancestors,omitempty"`
+
+	// Children Children of the item, if expanded
+	Children  *[]ItemList `json:"children,omitempty" yaml:"children,omitempty" xml:"children,omitempty" bson:"children,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+
+	// DeletedAt Date and time when the record was deleted
+	DeletedAt nullable.Nullable[time.Time] `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty" xml:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	Id        uint32                       `json:"id" yaml:"id" xml:"id" bson:"id"`
 
 	// Name Item name
 	Name string `json:"name" yaml:"name" xml:"name" bson:"name"`
 
+	// Parent Parent of the item, if expanded and readable
+	Parent *ItemList `json:"parent,omitempty" yaml:"parent,omitempty" xml:"parent,omitempty" bson:"parent,omitempty"`
+
 	// ParentId Parent record ID
 	ParentId  *uint32    `json:"parent_id,omitempty" yaml:"parent_id,omitempty" xml:"parent_id,omitempty" bson:"parent_id,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
@@ -51,7 +197,12 @@ type ItemList struct {
 
 // ItemRead defines model for ItemRead.
 type ItemRead struct {
-	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+	// Ancestors Readable ancestors of the item, root first, if expanded
+	Ancestors *[]ItemList `json:"ancestors,omitempty" yaml:"ancestors,omitempty" xml:"ancestors,omitempty" bson:"ancestors,omitempty"`
+
+	// Children Children of the item, if expanded
+	Children  *[]ItemList `json:"children,omitempty" yaml:"children,omitempty" xml:"children,omitempty" bson:"children,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
 
 	// DeletedAt Date and time when the record was deleted
 	DeletedAt nullable.Nullable[time.Time] `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty" xml:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
@@ -60,11 +211,41 @@ type ItemRead struct {
 	// Name Item name
 	Name string `json:"name" yaml:"name" xml:"name" bson:"name"`
 
+	// Parent Parent of the item, if expanded and readable
+	Parent *ItemList `json:"parent,omitempty" yaml:"parent,omitempty" xml:"parent,omitempty" bson:"parent,omitempty"`
+
 	// ParentId Parent record ID
 	ParentId  *uint32    `json:"parent_id,omitempty" yaml:"parent_id,omitempty" xml:"parent_id,omitempty" bson:"parent_id,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
 }
 
+// ItemRelation How two Items relate in the tree
+type ItemRelation struct {
+	// Ancestor Whether the Item is an ancestor of the other
+	Ancestor bool `json:"ancestor" yaml:"ancestor" xml:"ancestor" bson:"ancestor"`
+
+	// Descendant Whether the Item is a descendant of the other
+	Descendant bool `json:"descendant" yaml:"descendant" xml:"descendant" bson:"descendant"`
+
+	// Distance Number of edges on the path between the Items, absent if they are unrelated
+	Distance *int `json:"distance,omitempty" yaml:"distance,omitempty" xml:"distance,omitempty" bson:"distance,omitempty"`
+
+	// Id ID of the Item
+	Id uint32 `json:"id" yaml:"id" xml:"id" bson:"id"`
+
+	// Lca Lowest common ancestor, absent if the Items are unrelated, or it is not readable
+	Lca *ItemList `json:"lca,omitempty" yaml:"lca,omitempty" xml:"lca,omitempty" bson:"lca,omitempty"`
+
+	// Other ID of the other Item
+	Other uint32 `json:"other" yaml:"other" xml:"other" bson:"other"`
+
+	// Path Readable Items on the path from the Item up to the lowest common ancestor and down to the other, both inclusive. Empty if unrelated.
+	Path []ItemList `json:"path" yaml:"path" xml:"path" bson:"path"`
+
+	// Related Whether both Items are in the same tree
+	Related bool `json:"related" yaml:"related" xml:"related" bson:"related"`
+}
+
 // ItemUpdate defines model for ItemUpdate.
 type ItemUpdate struct {
 	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
@@ -81,7 +262,10 @@ type ItemUpdate struct {
 // ItemParentRead defines model for Item_ParentRead.
 type ItemParentRead struct {
 	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
-	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+
+	// DeletedAt Date and time when the record was deleted
+	DeletedAt nullable.Nullable[time.Time] `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty" xml:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	Id        uint32                       `json:"id" yaml:"id" xml:"id" bson:"id"`
 
 	// Name Item name
 	Name string `json:"name" yaml:"name" xml:"name" bson:"name"`
@@ -91,34 +275,210 @@ type ItemParentRead struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
 }
 
-// N400 defines model for 400.
-type N400 struct {
+// Permission Permission granted, `write` includes `read`, and `admin` includes both
+type Permission string
+
+// Problem Problem details of an error, as defined by RFC 7807
+type Problem struct {
+	// Detail Explanation of the occurrence
+	Detail *string `json:"detail,omitempty" yaml:"detail,omitempty" xml:"detail,omitempty" bson:"detail,omitempty"`
+
+	// Instance Path of the request
+	Instance *string `json:"instance,omitempty" yaml:"instance,omitempty" xml:"instance,omitempty" bson:"instance,omitempty"`
+
+	// InvalidParams Parameters or properties failing validation
+	InvalidParams *[]struct {
+		// In path, query, header or body
+		In *string `json:"in,omitempty" yaml:"in,omitempty" xml:"in,omitempty" bson:"in,omitempty"`
+
+		// Name Parameter name, or dot separated path of the property
+		Name *string `json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty" bson:"name,omitempty"`
+
+		// Reason What is wrong
+		Reason string `json:"reason" yaml:"reason" xml:"reason" bson:"reason"`
+	} `json:"invalid-params,omitempty" yaml:"invalid-params,omitempty" xml:"invalid-params,omitempty" bson:"invalid-params,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status" yaml:"status" xml:"status" bson:"status"`
+
+	// Title Summary of the problem type
+	Title string `json:"title" yaml:"title" xml:"title" bson:"title"`
+
+	// Type URI of the problem type, documented in README
+	Type string `json:"type" yaml:"type" xml:"type" bson:"type"`
+}
+
+// Trashed Whether trashed items are read. `1` and `true` are deprecated aliases of `with`, `0` and `false` of `without`.
+type Trashed string
+
+// WebhookCreate defines model for WebhookCreate.
+type WebhookCreate struct {
+	Active    bool       `json:"active" yaml:"active" xml:"active" bson:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty" yaml:"events,omitempty" xml:"events,omitempty" bson:"events,omitempty"`
+	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url" yaml:"url" xml:"url" bson:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  int64     `json:"attempts" yaml:"attempts" xml:"attempts" bson:"attempts"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at" xml:"created_at" bson:"created_at"`
+	Event     string    `json:"event" yaml:"event" xml:"event" bson:"event"`
+
+	// EventId ID of the event
+	EventId       uint64                `json:"event_id" yaml:"event_id" xml:"event_id" bson:"event_id"`
+	Id            uint64                `json:"id" yaml:"id" xml:"id" bson:"id"`
+	LastError     *string               `json:"last_error,omitempty" yaml:"last_error,omitempty" xml:"last_error,omitempty" bson:"last_error,omitempty"`
+	NextAttemptAt time.Time             `json:"next_attempt_at" yaml:"next_attempt_at" xml:"next_attempt_at" bson:"next_attempt_at"`
+	Payload       string                `json:"payload" yaml:"payload" xml:"payload" bson:"payload"`
+	Status        WebhookDeliveryStatus `json:"status" yaml:"status" xml:"status" bson:"status"`
+	UpdatedAt     time.Time             `json:"updated_at" yaml:"updated_at" xml:"updated_at" bson:"updated_at"`
+
+	// WebhookId ID of the webhook to deliver to
+	WebhookId uint32 `json:"webhook_id" yaml:"webhook_id" xml:"webhook_id" bson:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Active    bool       `json:"active" yaml:"active" xml:"active" bson:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty" yaml:"events,omitempty" xml:"events,omitempty" bson:"events,omitempty"`
+	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url" yaml:"url" xml:"url" bson:"url"`
+}
+
+// WebhookRead defines model for WebhookRead.
+type WebhookRead struct {
+	Active    bool       `json:"active" yaml:"active" xml:"active" bson:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty" yaml:"events,omitempty" xml:"events,omitempty" bson:"events,omitempty"`
+	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url" yaml:"url" xml:"url" bson:"url"`
+}
+
+// WebhookUpdate defines model for WebhookUpdate.
+type WebhookUpdate struct {
+	Active    bool       `json:"active" yaml:"active" xml:"active" bson:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" xml:"created_at,omitempty" bson:"created_at,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events    *[]string  `json:"events,omitempty" yaml:"events,omitempty" xml:"events,omitempty" bson:"events,omitempty"`
+	Id        uint32     `json:"id" yaml:"id" xml:"id" bson:"id"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" xml:"updated_at,omitempty" bson:"updated_at,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url" yaml:"url" xml:"url" bson:"url"`
+}
+
+// N400ApplicationJSON defines model for 400.
+type N400ApplicationJSON struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+
+// N400ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N400ApplicationProblemPlusJSON = Problem
+
+// N401ApplicationJSON defines model for 401.
+type N401ApplicationJSON struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+
+// N401ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N401ApplicationProblemPlusJSON = Problem
+
+// N403ApplicationJSON defines model for 403.
+type N403ApplicationJSON struct {
 	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
 	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
 	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
 }
 
-// N404 defines model for 404.
-type N404 struct {
+// N403ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N403ApplicationProblemPlusJSON = Problem
+
+// N404ApplicationJSON defines model for 404.
+type N404ApplicationJSON struct {
 	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
 	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
 	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
 }
 
-// N409 defines model for 409.
-type N409 struct {
+// N404ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N404ApplicationProblemPlusJSON = Problem
+
+// N409ApplicationJSON defines model for 409.
+type N409ApplicationJSON struct {
 	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
 	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
 	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
 }
 
-// N500 defines model for 500.
-type N500 struct {
+// N409ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N409ApplicationProblemPlusJSON = Problem
+
+// N413ApplicationJSON defines model for 413.
+type N413ApplicationJSON struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+
+// N413ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N413ApplicationProblemPlusJSON = Problem
+
+// N422ApplicationJSON defines model for 422.
+type N422ApplicationJSON struct {
 	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
 	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
 	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
 }
 
+// N422ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N422ApplicationProblemPlusJSON = Problem
+
+// N429ApplicationJSON defines model for 429.
+type N429ApplicationJSON struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+
+// N429ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N429ApplicationProblemPlusJSON = Problem
+
+// N500ApplicationJSON defines model for 500.
+type N500ApplicationJSON struct {
+	Code   int          `json:"code" yaml:"code" xml:"code" bson:"code"`
+	Errors *interface{} `json:"errors,omitempty" yaml:"errors,omitempty" xml:"errors,omitempty" bson:"errors,omitempty"`
+	Status string       `json:"status" yaml:"status" xml:"status" bson:"status"`
+}
+
+// N500ApplicationProblemPlusJSON Problem details of an error, as defined by RFC 7807
+type N500ApplicationProblemPlusJSON = Problem
+
 // ListItemParams defines parameters for ListItem.
 type ListItemParams struct {
 	// Page what page to render
@@ -130,10 +490,28 @@ type ListItemParams struct {
 	// Name Name of the item
 	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty" bson:"name,omitempty"`
 
-	// Trashed Whether to include trashed items
-	Trashed *bool `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
+	// Pagination Pagination mode, defaults to `offset`. `cursor` pagination neither counts nor skips items, and ignores `page`
+	Pagination *ListItemParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty" yaml:"pagination,omitempty" xml:"pagination,omitempty" bson:"pagination,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` or `prev_cursor` of a previous page, implying cursor pagination
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty" yaml:"cursor,omitempty" xml:"cursor,omitempty" bson:"cursor,omitempty"`
+
+	// AsOf Read items as they were at the given time, reconstructed from item history
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty" yaml:"as_of,omitempty" xml:"as_of,omitempty" bson:"as_of,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty" yaml:"fields,omitempty" xml:"fields,omitempty" bson:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty" yaml:"expand,omitempty" xml:"expand,omitempty" bson:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
 }
 
+// ListItemParamsPagination defines parameters for ListItem.
+type ListItemParamsPagination string
+
 // CreateItemJSONBody defines parameters for CreateItem.
 type CreateItemJSONBody struct {
 	// Name Item name
@@ -143,6 +521,58 @@ type CreateItemJSONBody struct {
 	ParentId *uint32 `json:"parent_id,omitempty" yaml:"parent_id,omitempty" xml:"parent_id,omitempty" bson:"parent_id,omitempty"`
 }
 
+// StreamItemEventsParams defines parameters for StreamItemEvents.
+type StreamItemEventsParams struct {
+	// LastEventID ID of the last event received
+	LastEventID *string `json:"Last-Event-ID,omitempty" yaml:"Last-Event-ID,omitempty" xml:"Last-Event-ID,omitempty" bson:"Last-Event-ID,omitempty"`
+}
+
+// ListWebhookParams defines parameters for ListWebhook.
+type ListWebhookParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty" yaml:"page,omitempty" xml:"page,omitempty" bson:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" yaml:"per_page,omitempty" xml:"per_page,omitempty" bson:"per_page,omitempty"`
+}
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	Active *bool `json:"active,omitempty" yaml:"active,omitempty" xml:"active,omitempty" bson:"active,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events *[]string `json:"events,omitempty" yaml:"events,omitempty" xml:"events,omitempty" bson:"events,omitempty"`
+
+	// Secret Secret used to sign the payloads
+	Secret string `json:"secret" yaml:"secret" xml:"secret" bson:"secret"`
+
+	// Url URL receiving the events
+	Url string `json:"url" yaml:"url" xml:"url" bson:"url"`
+}
+
+// UpdateWebhookJSONBody defines parameters for UpdateWebhook.
+type UpdateWebhookJSONBody struct {
+	Active *bool `json:"active,omitempty" yaml:"active,omitempty" xml:"active,omitempty" bson:"active,omitempty"`
+
+	// Events Events to deliver, any of `create`, `update`, `move`, `delete` and `restore`. All events are delivered if empty
+	Events *[]string `json:"events,omitempty" yaml:"events,omitempty" xml:"events,omitempty" bson:"events,omitempty"`
+
+	// Secret Secret used to sign the payloads
+	Secret *string `json:"secret,omitempty" yaml:"secret,omitempty" xml:"secret,omitempty" bson:"secret,omitempty"`
+
+	// Url URL receiving the events
+	Url *string `json:"url,omitempty" yaml:"url,omitempty" xml:"url,omitempty" bson:"url,omitempty"`
+}
+
+// ListWebhookDeadLetterParams defines parameters for ListWebhookDeadLetter.
+type ListWebhookDeadLetterParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty" yaml:"page,omitempty" xml:"page,omitempty" bson:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" yaml:"per_page,omitempty" xml:"per_page,omitempty" bson:"per_page,omitempty"`
+}
+
 // DeleteItemParams defines parameters for DeleteItem.
 type DeleteItemParams struct {
 	// Trashed Whether to include trashed items
@@ -151,8 +581,17 @@ type DeleteItemParams struct {
 
 // ReadItemParams defines parameters for ReadItem.
 type ReadItemParams struct {
-	// Trashed Whether to include trashed items
-	Trashed *bool `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
+	// AsOf Read items as they were at the given time, reconstructed from item history
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty" yaml:"as_of,omitempty" xml:"as_of,omitempty" bson:"as_of,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty" yaml:"fields,omitempty" xml:"fields,omitempty" bson:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty" yaml:"expand,omitempty" xml:"expand,omitempty" bson:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
 }
 
 // UpdateItemJSONBody defines parameters for UpdateItem.
@@ -177,10 +616,118 @@ type ListItemChildrenParams struct {
 
 	// Recurse Whether to return all descendants (recurse to last leaf)
 	Recurse *bool `form:"recurse,omitempty" json:"recurse,omitempty" yaml:"recurse,omitempty" xml:"recurse,omitempty" bson:"recurse,omitempty"`
+
+	// Pagination Pagination mode, defaults to `offset`. `cursor` pagination neither counts nor skips items, and ignores `page`
+	Pagination *ListItemChildrenParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty" yaml:"pagination,omitempty" xml:"pagination,omitempty" bson:"pagination,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` or `prev_cursor` of a previous page, implying cursor pagination
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty" yaml:"cursor,omitempty" xml:"cursor,omitempty" bson:"cursor,omitempty"`
+
+	// AsOf Read items as they were at the given time, reconstructed from item history
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty" yaml:"as_of,omitempty" xml:"as_of,omitempty" bson:"as_of,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty" yaml:"fields,omitempty" xml:"fields,omitempty" bson:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty" yaml:"expand,omitempty" xml:"expand,omitempty" bson:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
+}
+
+// ListItemChildrenParamsPagination defines parameters for ListItemChildren.
+type ListItemChildrenParamsPagination string
+
+// CopyItemJSONBody defines parameters for CopyItem.
+type CopyItemJSONBody struct {
+	// Depth Levels of descendants to copy, 0 copies the Item only. All descendants are copied if omitted.
+	Depth *int `json:"depth,omitempty" yaml:"depth,omitempty" xml:"depth,omitempty" bson:"depth,omitempty"`
+
+	// ParentId Parent of the copy, which is a root if omitted
+	ParentId *uint32 `json:"parent_id,omitempty" yaml:"parent_id,omitempty" xml:"parent_id,omitempty" bson:"parent_id,omitempty"`
+
+	// Suffix Appended to the name of the copy of the Item, but not its descendants
+	Suffix *string `json:"suffix,omitempty" yaml:"suffix,omitempty" xml:"suffix,omitempty" bson:"suffix,omitempty"`
 }
 
+// SetItemGrantJSONBody defines parameters for SetItemGrant.
+type SetItemGrantJSONBody struct {
+	// Permission Permission granted, `write` includes `read`, and `admin` includes both
+	Permission Permission `json:"permission" yaml:"permission" xml:"permission" bson:"permission"`
+}
+
+// ListItemHistoryParams defines parameters for ListItemHistory.
+type ListItemHistoryParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty" yaml:"page,omitempty" xml:"page,omitempty" bson:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" yaml:"per_page,omitempty" xml:"per_page,omitempty" bson:"per_page,omitempty"`
+}
+
+// ReadItemParentParams defines parameters for ReadItemParent.
+type ReadItemParentParams struct {
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
+}
+
+// RevertItemJSONBody defines parameters for RevertItem.
+type RevertItemJSONBody struct {
+	// Revision Revision number to revert to
+	Revision uint32 `json:"revision" yaml:"revision" xml:"revision" bson:"revision"`
+}
+
+// ListItemSiblingsParams defines parameters for ListItemSiblings.
+type ListItemSiblingsParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty" yaml:"page,omitempty" xml:"page,omitempty" bson:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" yaml:"per_page,omitempty" xml:"per_page,omitempty" bson:"per_page,omitempty"`
+
+	// Name Name of the item
+	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty" bson:"name,omitempty"`
+
+	// ExcludeSelf Whether to leave the Item out of its siblings
+	ExcludeSelf *bool `form:"exclude_self,omitempty" json:"exclude_self,omitempty" yaml:"exclude_self,omitempty" xml:"exclude_self,omitempty" bson:"exclude_self,omitempty"`
+
+	// Pagination Pagination mode, defaults to `offset`. `cursor` pagination neither counts nor skips items, and ignores `page`
+	Pagination *ListItemSiblingsParamsPagination `form:"pagination,omitempty" json:"pagination,omitempty" yaml:"pagination,omitempty" xml:"pagination,omitempty" bson:"pagination,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` or `prev_cursor` of a previous page, implying cursor pagination
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty" yaml:"cursor,omitempty" xml:"cursor,omitempty" bson:"cursor,omitempty"`
+
+	// Fields Comma separated fields to include, `id` and `name` are always included. All fields are included if omitted.
+	Fields *[]ItemField `form:"fields,omitempty" json:"fields,omitempty" yaml:"fields,omitempty" xml:"fields,omitempty" bson:"fields,omitempty"`
+
+	// Expand Comma separated edges to embed in items. Not supported with `as_of`.
+	Expand *[]ItemEdge `form:"expand,omitempty" json:"expand,omitempty" yaml:"expand,omitempty" xml:"expand,omitempty" bson:"expand,omitempty"`
+
+	// Trashed Read items `without` trashed ones (default), `with` trashed ones, or trashed ones `only`
+	Trashed *Trashed `form:"trashed,omitempty" json:"trashed,omitempty" yaml:"trashed,omitempty" xml:"trashed,omitempty" bson:"trashed,omitempty"`
+}
+
+// ListItemSiblingsParamsPagination defines parameters for ListItemSiblings.
+type ListItemSiblingsParamsPagination string
+
 // CreateItemJSONRequestBody defines body for CreateItem for application/json ContentType.
 type CreateItemJSONRequestBody CreateItemJSONBody
 
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody UpdateWebhookJSONBody
+
 // UpdateItemJSONRequestBody defines body for UpdateItem for application/json ContentType.
 type UpdateItemJSONRequestBody UpdateItemJSONBody
+
+// CopyItemJSONRequestBody defines body for CopyItem for application/json ContentType.
+type CopyItemJSONRequestBody CopyItemJSONBody
+
+// SetItemGrantJSONRequestBody defines body for SetItemGrant for application/json ContentType.
+type SetItemGrantJSONRequestBody SetItemGrantJSONBody
+
+// RevertItemJSONRequestBody defines body for RevertItem for application/json ContentType.
+type RevertItemJSONRequestBody RevertItemJSONBody
@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/eidng8/go-ent/paginate"
+	"github.com/oapi-codegen/nullable"
 
 	"github.com/eidng8/go-simple-tree/ent"
 )
@@ -37,6 +38,9 @@ func newItemListFromEnt(eaa *ent.Item) ItemList {
 		val := *eaa.ParentID
 		aa.ParentId = &val
 	}
+	if eaa.DeletedAt != nil {
+		aa.DeletedAt = nullable.NewNullableWithValue(*eaa.DeletedAt)
+	}
 	aa.CreatedAt = eaa.CreatedAt
 	aa.UpdatedAt = eaa.UpdatedAt
 	return aa
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// FieldError describes a part of the request failing validation.
+type FieldError struct {
+	// In is where the field is: `path`, `query`, `header` or `body`. It's
+	// empty if unknown.
+	In string `json:"in,omitempty"`
+	// Field is the name of the parameter, or the dot separated path of the
+	// property in the body. It's empty if the body as a whole is invalid.
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// validationMiddleware answers requests not conforming to the spec with
+// `400`, listing every failing field. Paths of the spec are expected to be
+// rebased already. Requests not routed to any operation are passed on.
+// Security requirements are left to the authentication middleware.
+func validationMiddleware(swagger *openapi3.T) gin.HandlerFunc {
+	routes := map[string]*routers.Route{}
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			routes[method+" "+ginPath(path)] = &routers.Route{
+				Spec:      swagger,
+				Path:      path,
+				PathItem:  item,
+				Method:    method,
+				Operation: op,
+			}
+		}
+	}
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+	return func(gc *gin.Context) {
+		route, ok := routes[gc.Request.Method+" "+routePath(gc)]
+		if !ok {
+			gc.Next()
+			return
+		}
+		// bodies without content type were taken as JSON before requests
+		// were validated, and still are
+		if nil != gc.Request.Body && http.NoBody != gc.Request.Body &&
+			"" == gc.GetHeader("Content-Type") {
+			gc.Request.Header.Set("Content-Type", "application/json")
+		}
+		params := make(map[string]string, len(gc.Params))
+		for _, p := range gc.Params {
+			params[p.Key] = p.Value
+		}
+		err := openapi3filter.ValidateRequest(
+			gc.Request.Context(),
+			&openapi3filter.RequestValidationInput{
+				Request:    gc.Request,
+				PathParams: params,
+				Route:      route,
+				Options:    options,
+			},
+		)
+		if err != nil {
+			abortBadRequest(gc, err, fieldErrors(nil, err, "", ""))
+			return
+		}
+		gc.Next()
+	}
+}
+
+// abortBadRequest answers the request with `400`, listing the failing fields.
+func abortBadRequest(gc *gin.Context, err error, fields []FieldError) {
+	_ = gc.Error(err)
+	abortWithError(gc, newApiError(http.StatusBadRequest, err, fields))
+}
+
+// parameterErrorHandler answers requests with parameters the generated
+// wrappers fail to bind with `400`. Parameters are usually rejected by
+// validationMiddleware before reaching the wrappers.
+func parameterErrorHandler(gc *gin.Context, err error, _ int) {
+	abortBadRequest(gc, err, []FieldError{{Message: err.Error()}})
+}
+
+// fieldErrors appends the failing fields found in the validation error to
+// the list. `in` and `field` locate the value the error is about.
+func fieldErrors(list []FieldError, err error, in, field string) []FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, err := range e {
+			list = fieldErrors(list, err, in, field)
+		}
+		return list
+	case *openapi3filter.RequestError:
+		switch {
+		case nil != e.Parameter:
+			in, field = e.Parameter.In, e.Parameter.Name
+		case nil != e.RequestBody:
+			in = "body"
+		}
+		if nil == e.Err {
+			return append(
+				list, FieldError{In: in, Field: field, Message: e.Reason},
+			)
+		}
+		return fieldErrors(list, e.Err, in, field)
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			field = strings.Trim(field+"."+strings.Join(pointer, "."), ".")
+		}
+		msg := e.Reason
+		if "" == msg {
+			msg = "doesn't match schema " + e.SchemaField
+		}
+		return append(list, FieldError{In: in, Field: field, Message: msg})
+	}
+	return append(list, FieldError{In: in, Field: field, Message: err.Error()})
+}
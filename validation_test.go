@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent/schema"
+)
+
+func Test_validationMiddleware_lists_failing_parameters(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodGet, schema.BaseUri+"?page=0&per_page=x", "",
+	)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	var actual struct {
+		Code   int          `json:"code"`
+		Errors []FieldError `json:"errors"`
+	}
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, http.StatusBadRequest, actual.Code)
+	assert.Len(t, actual.Errors, 2)
+	assert.ElementsMatch(
+		t, []string{"page", "per_page"},
+		[]string{actual.Errors[0].Field, actual.Errors[1].Field},
+	)
+	assert.Equal(t, "query", actual.Errors[0].In)
+}
+
+func Test_validationMiddleware_rejects_invalid_path_parameters(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(engine, http.MethodGet, schema.BaseUri+"/0", "")
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), `"in":"path","field":"id"`)
+}
+
+func Test_validationMiddleware_rejects_unknown_body_properties(t *testing.T) {
+	_, engine, entClient, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"new","extra":1}`,
+	)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), `"in":"body"`)
+	assert.Contains(t, res.Body.String(), `property \"extra\" is unsupported`)
+	assert.Equal(t, 50, entClient.Item.Query().CountX(context.Background()))
+}
+
+func Test_validationMiddleware_requires_json_bodies(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"new"}`,
+		withHeader("Content-Type", "text/plain"),
+	)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), `"in":"body"`)
+}
+
+func Test_validationMiddleware_passes_valid_requests(t *testing.T) {
+	_, engine, _, _ := setupGinTest(t)
+	res := serveRequest(
+		engine, http.MethodPost, schema.BaseUri, `{"name":"new","parent_id":1}`,
+	)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	res = serveRequest(engine, http.MethodGet, "/healthz", "")
+	assert.Equal(t, http.StatusOK, res.Code)
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/webhook"
+	"github.com/eidng8/go-simple-tree/ent/webhookdelivery"
+)
+
+// Headers sent along with webhook payloads.
+const (
+	// HeaderWebhookId carries the delivery ID, which is the same for retries.
+	HeaderWebhookId        = "X-Webhook-Id"
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	// HeaderWebhookSignature carries `sha256=` followed by the hex encoded
+	// HMAC-SHA256 of `{timestamp}.{payload}`, keyed by the webhook secret.
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// webhookBatchSize is the maximum number of deliveries attempted at a time.
+const webhookBatchSize = 100
+
+// WebhookPayload is the JSON body delivered to webhooks.
+type WebhookPayload struct {
+	Event     string        `json:"event"`
+	Time      time.Time     `json:"time"`
+	Data      ItemEventData `json:"data"`
+	RequestId string        `json:"request_id,omitempty"`
+}
+
+// WebhookDispatcher is the event sink delivering item events to webhooks.
+// Events are persisted as deliveries first, which are then retried with
+// exponential backoff until they succeed, or become dead letters after
+// MaxAttempts.
+type WebhookDispatcher struct {
+	EC     *ent.Client
+	Client *http.Client
+	// MaxAttempts is the number of attempts made before giving up.
+	MaxAttempts uint32
+	// Backoff is the delay before the first retry, doubled for each retry
+	// after it, up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// PollInterval is how often due retries are checked.
+	PollInterval time.Duration
+	wake         chan struct{}
+}
+
+func newWebhookDispatcher(
+	ec *ent.Client, cfg WebhooksConfig,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		EC:           ec,
+		Client:       &http.Client{Timeout: cfg.Timeout},
+		MaxAttempts:  uint32(cfg.MaxAttempts),
+		Backoff:      cfg.Backoff,
+		MaxBackoff:   time.Hour,
+		PollInterval: time.Second,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// Publish creates deliveries of the event, and wakes the dispatcher up to
+// deliver them.
+func (d *WebhookDispatcher) Publish(ctx context.Context, event Event) error {
+	if err := d.Enqueue(ctx, event); err != nil {
+		return err
+	}
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run delivers events until the context is done. Deliveries in progress are
+// finished before returning, which is bounded by the timeout of the client.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	work := context.WithoutCancel(ctx)
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+	for {
+		d.logError(d.DeliverDue(work))
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Enqueue creates a delivery of the event for every active webhook
+// subscribing to it, unless it has been created before.
+func (d *WebhookDispatcher) Enqueue(ctx context.Context, event Event) error {
+	hooks, err := d.EC.Webhook.Query().Where(webhook.Active(true)).All(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := d.EC.WebhookDelivery.Query().
+		Where(webhookdelivery.EventID(event.Id)).
+		Select(webhookdelivery.FieldWebhookID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	enqueued := make([]uint32, len(rows))
+	for i, row := range rows {
+		enqueued[i] = row.WebhookID
+	}
+	payload, err := json.Marshal(
+		WebhookPayload{
+			Event: event.Type, Time: event.Time, Data: event.Data,
+			RequestId: event.RequestId,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	var creates []*ent.WebhookDeliveryCreate
+	for _, wh := range hooks {
+		if len(wh.Events) > 0 && !slices.Contains(wh.Events, event.Type) ||
+			slices.Contains(enqueued, wh.ID) {
+			continue
+		}
+		creates = append(
+			creates, d.EC.WebhookDelivery.Create().
+				SetWebhookID(wh.ID).
+				SetEventID(event.Id).
+				SetEvent(event.Type).
+				SetPayload(string(payload)).
+				SetCreatedAt(event.Time).
+				SetNextAttemptAt(event.Time),
+		)
+	}
+	if 0 == len(creates) {
+		return nil
+	}
+	return d.EC.WebhookDelivery.CreateBulk(creates...).Exec(ctx)
+}
+
+// DeliverDue attempts all pending deliveries that are due.
+func (d *WebhookDispatcher) DeliverDue(ctx context.Context) error {
+	hooks, err := d.EC.Webhook.Query().Where(webhook.Active(true)).All(ctx)
+	if err != nil || 0 == len(hooks) {
+		return err
+	}
+	byId := make(map[uint32]*ent.Webhook, len(hooks))
+	ids := make([]uint32, len(hooks))
+	for i, wh := range hooks {
+		byId[wh.ID] = wh
+		ids[i] = wh.ID
+	}
+	deliveries, err := d.EC.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+			webhookdelivery.WebhookIDIn(ids...),
+		).
+		Order(webhookdelivery.ByID()).
+		Limit(webhookBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		if err = d.attempt(ctx, byId[delivery.WebhookID], delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attempt sends the delivery and records the outcome. Only errors recording
+// the outcome are returned.
+func (d *WebhookDispatcher) attempt(
+	ctx context.Context, wh *ent.Webhook, delivery *ent.WebhookDelivery,
+) error {
+	attempts := delivery.Attempts + 1
+	du := d.EC.WebhookDelivery.UpdateOne(delivery).SetAttempts(attempts)
+	err := d.send(ctx, wh, delivery)
+	switch {
+	case nil == err:
+		du.SetStatus(webhookdelivery.StatusDelivered).ClearLastError()
+	case attempts >= d.MaxAttempts:
+		du.SetStatus(webhookdelivery.StatusDead).SetLastError(err.Error())
+	default:
+		du.SetNextAttemptAt(time.Now().Add(d.backoff(attempts))).
+			SetLastError(err.Error())
+	}
+	return du.Exec(ctx)
+}
+
+func (d *WebhookDispatcher) send(
+	ctx context.Context, wh *ent.Webhook, delivery *ent.WebhookDelivery,
+) error {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, wh.URL, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookId, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(HeaderWebhookEvent, delivery.Event)
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(
+		HeaderWebhookSignature,
+		"sha256="+signWebhookPayload(wh.Secret, timestamp, body),
+	)
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// backoff returns the delay before the next attempt, after the given number of
+// failed attempts.
+func (d *WebhookDispatcher) backoff(attempts uint32) time.Duration {
+	delay := d.Backoff
+	for i := uint32(1); i < attempts && delay < d.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.MaxBackoff)
+}
+
+func (d *WebhookDispatcher) logError(err error) {
+	if err != nil {
+		slog.Error("Failed to dispatch webhooks", "error", err)
+	}
+}
+
+// signWebhookPayload returns the hex encoded HMAC-SHA256 signature of the
+// payload sent at the given timestamp.
+func signWebhookPayload(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eidng8/go-simple-tree/ent"
+	"github.com/eidng8/go-simple-tree/ent/schema"
+	"github.com/eidng8/go-simple-tree/ent/webhookdelivery"
+)
+
+const testWebhookSecret = "0123456789abcdef"
+
+// webhookReceiver is a local webhook endpoint recording deliveries.
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	received []*http.Request
+	bodies   []string
+}
+
+// newWebhookReceiver returns a receiver responding 500 to the given number of
+// requests, before responding 204.
+func newWebhookReceiver(tb testing.TB, failures int) *webhookReceiver {
+	r := &webhookReceiver{failures: failures}
+	r.Server = httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, req *http.Request) {
+				body, _ := io.ReadAll(req.Body)
+				r.mu.Lock()
+				defer r.mu.Unlock()
+				r.received = append(r.received, req)
+				r.bodies = append(r.bodies, string(body))
+				if len(r.received) <= r.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	tb.Cleanup(r.Close)
+	return r
+}
+
+func (r *webhookReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.received)
+}
+
+func (r *webhookReceiver) request(i int) (*http.Request, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.received[i], r.bodies[i]
+}
+
+func newTestDispatcher(client *ent.Client) *WebhookDispatcher {
+	d := newWebhookDispatcher(client, defaultConfig().Webhooks)
+	d.MaxAttempts = 3
+	d.Backoff = time.Millisecond
+	d.PollInterval = time.Hour
+	return d
+}
+
+func createTestWebhook(
+	tb testing.TB, client *ent.Client, url string, events ...string,
+) *ent.Webhook {
+	wc := client.Webhook.Create().SetURL(url).SetSecret(testWebhookSecret)
+	if len(events) > 0 {
+		wc.SetEvents(events)
+	}
+	return wc.SaveX(context.Background())
+}
+
+func Test_WebhookDispatcher_delivers_signed_payload(t *testing.T) {
+	server, engine, entClient, _ := setupGinTest(t)
+	receiver := newWebhookReceiver(t, 0)
+	createTestWebhook(t, entClient, receiver.URL)
+	d := newTestDispatcher(entClient)
+	server.Outbox.Sinks = append(server.Outbox.Sinks, d)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+	res := serveRequest(
+		engine, http.MethodPatch, schema.BaseUri+"/2", `{"parent_id":1}`,
+	)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Nil(t, server.Outbox.RelayPending(context.Background()))
+	assert.Eventually(
+		t, func() bool { return receiver.count() > 0 },
+		time.Second, time.Millisecond,
+	)
+	cancel()
+	<-done
+	req, body := receiver.request(0)
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, EventMove, req.Header.Get(HeaderWebhookEvent))
+	assert.Equal(
+		t,
+		"sha256="+signWebhookPayload(
+			testWebhookSecret, req.Header.Get(HeaderWebhookTimestamp),
+			[]byte(body),
+		),
+		req.Header.Get(HeaderWebhookSignature),
+	)
+	var payload WebhookPayload
+	assert.Nil(t, json.Unmarshal([]byte(body), &payload))
+	assert.Equal(t, EventMove, payload.Event)
+	assert.Equal(t, uint32(2), payload.Data.Id)
+	assert.Equal(t, uint32(1), *payload.Data.ParentId)
+	assert.Nil(t, payload.Data.PreviousParentId)
+	id, err := strconv.ParseUint(req.Header.Get(HeaderWebhookId), 10, 64)
+	assert.Nil(t, err)
+	delivery := entClient.WebhookDelivery.GetX(context.Background(), id)
+	assert.Equal(t, webhookdelivery.StatusDelivered, delivery.Status)
+	assert.Equal(t, uint32(1), delivery.Attempts)
+}
+
+func Test_WebhookDispatcher_only_delivers_subscribed_events(t *testing.T) {
+	_, _, entClient, _ := setupGinTest(t)
+	receiver := newWebhookReceiver(t, 0)
+	createTestWebhook(t, entClient, receiver.URL, EventDelete)
+	inactive := createTestWebhook(t, entClient, receiver.URL)
+	entClient.Webhook.UpdateOne(inactive).SetActive(false).
+		ExecX(context.Background())
+	d := newTestDispatcher(entClient)
+	ctx := context.Background()
+	assert.Nil(t, d.Enqueue(ctx, Event{Id: 1, Type: EventUpdate}))
+	assert.Nil(t, d.Enqueue(ctx, Event{Id: 2, Type: EventDelete}))
+	assert.Nil(t, d.DeliverDue(ctx))
+	assert.Equal(t, 1, receiver.count())
+	req, _ := receiver.request(0)
+	assert.Equal(t, EventDelete, req.Header.Get(HeaderWebhookEvent))
+	assert.Equal(t, 1, entClient.WebhookDelivery.Query().CountX(ctx))
+}
+
+func Test_WebhookDispatcher_enqueues_relayed_event_once(t *testing.T) {
+	_, _, entClient, _ := setupGinTest(t)
+	receiver := newWebhookReceiver(t, 0)
+	createTestWebhook(t, entClient, receiver.URL)
+	d := newTestDispatcher(entClient)
+	ctx := context.Background()
+	event := Event{Id: 1, Type: EventCreate}
+	assert.Nil(t, d.Publish(ctx, event))
+	assert.Nil(t, d.Publish(ctx, event))
+	assert.Nil(t, d.DeliverDue(ctx))
+	assert.Equal(t, 1, receiver.count())
+	assert.Equal(t, 1, entClient.WebhookDelivery.Query().CountX(ctx))
+}
+
+func Test_WebhookDispatcher_retries_with_backoff(t *testing.T) {
+	_, _, entClient, _ := setupGinTest(t)
+	receiver := newWebhookReceiver(t, 2)
+	createTestWebhook(t, entClient, receiver.URL)
+	d := newTestDispatcher(entClient)
+	d.Backoff = 200 * time.Millisecond
+	ctx := context.Background()
+	assert.Nil(t, d.Enqueue(ctx, Event{Id: 1, Type: EventCreate}))
+	assert.Nil(t, d.DeliverDue(ctx))
+	assert.Equal(t, 1, receiver.count())
+	// the retry isn't due yet
+	assert.Nil(t, d.DeliverDue(ctx))
+	assert.Equal(t, 1, receiver.count())
+	delivery := entClient.WebhookDelivery.Query().OnlyX(ctx)
+	assert.Equal(t, webhookdelivery.StatusPending, delivery.Status)
+	assert.Equal(t, "webhook responded with status 500", *delivery.LastError)
+	assert.Eventually(
+		t, func() bool {
+			assert.Nil(t, d.DeliverDue(ctx))
+			return receiver.count() == 3
+		}, 2*time.Second, 5*time.Millisecond,
+	)
+	delivery = entClient.WebhookDelivery.Query().OnlyX(ctx)
+	assert.Equal(t, webhookdelivery.StatusDelivered, delivery.Status)
+	assert.Equal(t, uint32(3), delivery.Attempts)
+	assert.Nil(t, delivery.LastError)
+	_, first := receiver.request(0)
+	_, last := receiver.request(2)
+	assert.Equal(t, first, last)
+}
+
+func Test_WebhookDispatcher_moves_failed_delivery_to_dead_letters(t *testing.T) {
+	_, engine, entClient, res := setupGinTest(t)
+	receiver := newWebhookReceiver(t, 10)
+	wh := createTestWebhook(t, entClient, receiver.URL)
+	d := newTestDispatcher(entClient)
+	ctx := context.Background()
+	assert.Nil(t, d.Enqueue(ctx, Event{Id: 1, Type: EventCreate}))
+	assert.Eventually(
+		t, func() bool {
+			assert.Nil(t, d.DeliverDue(ctx))
+			return receiver.count() == 3
+		}, time.Second, 5*time.Millisecond,
+	)
+	assert.Nil(t, d.DeliverDue(ctx))
+	assert.Equal(t, 3, receiver.count())
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		schema.BaseUri+"/webhooks/"+strconv.Itoa(int(wh.ID))+"/dead-letters",
+		nil,
+	)
+	engine.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual ListWebhookDeadLetter200JSONResponse
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, 1, actual.Total)
+	assert.Equal(t, Dead, actual.Data[0].Status)
+	assert.Equal(t, int64(3), actual.Data[0].Attempts)
+	assert.Equal(
+		t, "webhook responded with status 500", *actual.Data[0].LastError,
+	)
+}
+
+func Test_WebhookDispatcher_backoff_is_capped(t *testing.T) {
+	d := &WebhookDispatcher{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, d.backoff(1))
+	assert.Equal(t, 2*time.Second, d.backoff(2))
+	assert.Equal(t, 4*time.Second, d.backoff(3))
+	assert.Equal(t, 5*time.Second, d.backoff(4))
+	assert.Equal(t, 5*time.Second, d.backoff(40))
+}